@@ -0,0 +1,42 @@
+package wow
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AchievementErrors holds the errors of the achievements that
+// GetAchievementsByIds couldn't fetch, keyed by id.
+type AchievementErrors map[int]error
+
+func (e AchievementErrors) Error() string {
+	ids := make([]int, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	messages := make([]string, 0, len(ids))
+	for _, id := range ids {
+		messages = append(messages, fmt.Sprintf("%d: %s", id, e[id]))
+	}
+	return "Could not fetch achievements: " + strings.Join(messages, "; ")
+}
+
+// GetAchievementsByIds fetches the achievements with the given ids,
+// keyed by id, running up to concurrency requests at once (0 means
+// DefaultBatchConcurrency). Achievements are cached, so fetching them
+// again doesn't make requests. Achievements that fail to load are left
+// out and their errors returned as AchievementErrors. (GetAchievements
+// returns the achievements data resource instead.)
+func (a *ApiClient) GetAchievementsByIds(ids []int, concurrency int) (map[int]*Achievement, error) {
+	resolved, failed := a.resolveIds(ids, concurrency, "achievement/%d", func() interface{} { return &Achievement{} })
+	achievements := make(map[int]*Achievement)
+	for id, achievement := range resolved {
+		achievements[id] = achievement.(*Achievement)
+	}
+	if len(failed) > 0 {
+		return achievements, AchievementErrors(failed)
+	}
+	return achievements, nil
+}
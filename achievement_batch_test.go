@@ -0,0 +1,60 @@
+package wow
+
+import (
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+type AchievementBatchSuite struct{}
+
+var _ = Suite(&AchievementBatchSuite{})
+
+func (s *AchievementBatchSuite) Test_GetAchievementsByIds(c *C) {
+	var mutex sync.Mutex
+	inFlight, maxInFlight, requests := 0, 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests++
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mutex.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mutex.Lock()
+		inFlight--
+		mutex.Unlock()
+
+		id := strings.TrimPrefix(r.URL.Path, "/wow/achievement/")
+		if id == "404" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %s, "title": "Achievement %s", "points": %s}`, id, id, id)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	ids := []int{6, 7, 8, 9, 10, 11, 404, 6}
+	achievements, err := client.GetAchievementsByIds(ids, 2)
+	c.Assert(len(achievements), Equals, 6)
+	c.Assert(achievements[7].Title, Equals, "Achievement 7")
+	c.Assert(achievements[11].Points, Equals, 11)
+	failed, ok := err.(AchievementErrors)
+	c.Assert(ok, Equals, true)
+	c.Assert(len(failed), Equals, 1)
+	c.Assert(failed[404], NotNil)
+	c.Assert(err, ErrorMatches, "Could not fetch achievements: 404: API request failed: 404 Not Found")
+	c.Assert(requests, Equals, 7)
+	c.Assert(maxInFlight <= 2, Equals, true)
+
+	achievements, err = client.GetAchievementsByIds([]int{6, 7}, 0)
+	c.Assert(err, IsNil)
+	c.Assert(len(achievements), Equals, 2)
+	c.Assert(requests, Equals, 7)
+}
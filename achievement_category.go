@@ -0,0 +1,100 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+)
+
+// GetAchievementsInCategory looks up the achievement category with the
+// given id in the achievements data resource and fetches each of its
+// achievements. Subcategories aren't included. Achievements that fail
+// to load are left out and their errors returned.
+func (a *ApiClient) GetAchievementsInCategory(categoryId int) ([]*Achievement, []error) {
+	category, err := a.getAchievementCategory(categoryId)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	achievements := make([]*Achievement, len(category.Achievements))
+	errs := a.forEach(len(category.Achievements), 0, func(i int) error {
+		id := category.Achievements[i].Id
+		achievement := &Achievement{}
+		err := a.getCached(fmt.Sprintf("achievement/%d", id), nil, achievement)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Could not load achievement %d: %s", id, err))
+		}
+		achievements[i] = achievement
+		return nil
+	})
+
+	loaded := make([]*Achievement, 0, len(achievements))
+	for _, achievement := range achievements {
+		if achievement != nil {
+			loaded = append(loaded, achievement)
+		}
+	}
+	return loaded, compactErrors(errs)
+}
+
+// MissingAchievements returns the achievements of the category with
+// the given id, as listed by the achievements data resource, that the
+// character hasn't completed. Subcategories aren't included. The
+// character must have been fetched with the "achievements" field.
+func (a *ApiClient) MissingAchievements(c *Character, categoryId int) ([]*Achievement, error) {
+	if c.Achievements == nil {
+		return nil, errors.New(fmt.Sprintf("Character %s has no achievements; request the 'achievements' field to find missing ones", c.Name))
+	}
+	category, err := a.getAchievementCategory(categoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := make(map[int]bool)
+	for _, id := range c.Achievements.AchievementsCompleted {
+		completed[id] = true
+	}
+	missing := make([]*Achievement, 0)
+	for _, achievement := range category.Achievements {
+		if !completed[achievement.Id] {
+			missing = append(missing, achievement)
+		}
+	}
+	return missing, nil
+}
+
+// getAchievementCategory finds a category, at any depth, in the
+// achievements data resource.
+func (a *ApiClient) getAchievementCategory(categoryId int) (*Achievement, error) {
+	data := &achievementData{}
+	err := a.getCached("data/character/achievements", nil, data)
+	if err != nil {
+		return nil, err
+	}
+	category := findAchievementCategory(data.Achievements, categoryId)
+	if category == nil {
+		return nil, errors.New(fmt.Sprintf("Achievement category %d does not exist", categoryId))
+	}
+	return category, nil
+}
+
+func findAchievementCategory(categories []*Achievement, categoryId int) *Achievement {
+	for _, category := range categories {
+		if category.Id == categoryId {
+			return category
+		}
+		if found := findAchievementCategory(category.Categories, categoryId); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func compactErrors(errs []error) []error {
+	compacted := make([]error, 0)
+	for _, err := range errs {
+		if err != nil {
+			compacted = append(compacted, err)
+		}
+	}
+	return compacted
+}
@@ -0,0 +1,72 @@
+package wow
+
+import (
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type AchievementCategorySuite struct{}
+
+var _ = Suite(&AchievementCategorySuite{})
+
+func achievementServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/data/character/achievements":
+			w.Write([]byte(`{"achievements": [
+				{"id": 92, "name": "General", "achievements": [{"id": 6}, {"id": 7}]},
+				{"id": 96, "name": "Quests", "achievements": [{"id": 503}], "categories": [
+					{"id": 14861, "name": "Classic", "achievements": [{"id": 1676}, {"id": 404}, {"id": 1678}]}
+				]}
+			]}`))
+		case "/wow/achievement/404":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			fmt.Fprintf(w, `{"id": %s, "title": "Achievement"}`, strings.TrimPrefix(r.URL.Path, "/wow/achievement/"))
+		}
+	}))
+}
+
+func (s *AchievementCategorySuite) Test_GetAchievementsInCategory(c *C) {
+	server := achievementServer()
+	defer server.Close()
+	client := testClient(server)
+
+	a, errs := client.GetAchievementsInCategory(14861)
+	c.Assert(len(errs), Equals, 1)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[0].Id, Equals, 1676)
+	c.Assert(a[1].Id, Equals, 1678)
+
+	a, errs = client.GetAchievementsInCategory(92)
+	c.Assert(len(errs), Equals, 0)
+	c.Assert(len(a), Equals, 2)
+
+	_, errs = client.GetAchievementsInCategory(1)
+	c.Assert(errs[0].Error(), Equals, "Achievement category 1 does not exist")
+}
+
+func (s *AchievementCategorySuite) Test_MissingAchievements(c *C) {
+	server := achievementServer()
+	defer server.Close()
+	client := testClient(server)
+
+	char := &Character{Name: "Capoferro", Achievements: &AchievementList{AchievementsCompleted: []int{6, 404, 1676}}}
+	missing, err := client.MissingAchievements(char, 14861)
+	c.Assert(err, IsNil)
+	c.Assert(len(missing), Equals, 1)
+	c.Assert(missing[0].Id, Equals, 1678)
+
+	missing, err = client.MissingAchievements(char, 92)
+	c.Assert(err, IsNil)
+	c.Assert(len(missing), Equals, 1)
+	c.Assert(missing[0].Id, Equals, 7)
+
+	_, err = client.MissingAchievements(char, 1)
+	c.Assert(err, ErrorMatches, "Achievement category 1 does not exist")
+	_, err = client.MissingAchievements(&Character{Name: "Capoferro"}, 92)
+	c.Assert(err, ErrorMatches, "Character Capoferro has no achievements; .*")
+}
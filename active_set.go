@@ -0,0 +1,86 @@
+package wow
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ActiveSet describes an item set a character has pieces of equipped.
+type ActiveSet struct {
+	Set            *ItemSet
+	EquippedPieces int
+	// Bonuses whose threshold is met by EquippedPieces.
+	ActiveBonuses []*SetBonus
+	// The next bonus to unlock, or nil if every bonus is active.
+	NextBonus *SetBonus
+}
+
+// IsComplete reports whether every bonus of the set is active.
+func (s *ActiveSet) IsComplete() bool {
+	return s.NextBonus == nil
+}
+
+// TierSetStatus fetches the character's items and reports each item
+// set it has pieces of equipped. Item and item set lookups are cached,
+// so checking many characters wearing the same sets is cheap.
+func (a *ApiClient) TierSetStatus(realm string, characterName string) ([]*ActiveSet, error) {
+	char, err := a.GetCharacterWithFields(realm, characterName, []string{"items"})
+	if err != nil {
+		return nil, err
+	}
+	activeSets := make([]*ActiveSet, 0)
+	if char.Items == nil {
+		return activeSets, nil
+	}
+
+	equipped := make(map[int]bool)
+	for _, item := range char.Items.Slots() {
+		equipped[item.Id] = true
+	}
+
+	seen := make(map[int]bool)
+	for _, name := range itemSlots {
+		item := char.Items.Slot(name)
+		if item == nil || item.TooltipParams == nil || len(item.TooltipParams.Set) == 0 {
+			continue
+		}
+		fullItem := &Item{}
+		err = a.getCached(fmt.Sprintf("item/%d", item.Id), nil, fullItem)
+		if err != nil {
+			return nil, err
+		}
+		if fullItem.ItemSet == nil || seen[fullItem.ItemSet.Id] {
+			continue
+		}
+		seen[fullItem.ItemSet.Id] = true
+
+		set := &ItemSet{}
+		err = a.getCached(fmt.Sprintf("item/set/%d", fullItem.ItemSet.Id), nil, set)
+		if err != nil {
+			return nil, err
+		}
+		activeSets = append(activeSets, newActiveSet(set, equipped))
+	}
+	return activeSets, nil
+}
+
+func newActiveSet(set *ItemSet, equipped map[int]bool) *ActiveSet {
+	activeSet := &ActiveSet{Set: set, ActiveBonuses: make([]*SetBonus, 0)}
+	for _, id := range set.Items {
+		if equipped[id] {
+			activeSet.EquippedPieces++
+		}
+	}
+
+	bonuses := make([]*SetBonus, len(set.SetBonuses))
+	copy(bonuses, set.SetBonuses)
+	sort.Slice(bonuses, func(i, j int) bool { return bonuses[i].Threshold < bonuses[j].Threshold })
+	for _, bonus := range bonuses {
+		if bonus.Threshold <= activeSet.EquippedPieces {
+			activeSet.ActiveBonuses = append(activeSet.ActiveBonuses, bonus)
+		} else if activeSet.NextBonus == nil {
+			activeSet.NextBonus = bonus
+		}
+	}
+	return activeSet
+}
@@ -0,0 +1,31 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type ActiveSetSuite struct{}
+
+var _ = Suite(&ActiveSetSuite{})
+
+func (s *ActiveSetSuite) Test_newActiveSet(c *C) {
+	set := &ItemSet{
+		Id:    1172,
+		Items: []int{99542, 99544, 99540, 99541, 99543},
+		SetBonuses: []*SetBonus{
+			&SetBonus{Description: "4 piece", Threshold: 4},
+			&SetBonus{Description: "2 piece", Threshold: 2},
+		},
+	}
+
+	a := newActiveSet(set, map[int]bool{99542: true, 99544: true, 99540: true, 1: true})
+	c.Assert(a.EquippedPieces, Equals, 3)
+	c.Assert(len(a.ActiveBonuses), Equals, 1)
+	c.Assert(a.ActiveBonuses[0].Threshold, Equals, 2)
+	c.Assert(a.NextBonus.Threshold, Equals, 4)
+	c.Assert(a.IsComplete(), Equals, false)
+
+	a = newActiveSet(set, map[int]bool{99542: true, 99544: true, 99540: true, 99541: true})
+	c.Assert(len(a.ActiveBonuses), Equals, 2)
+	c.Assert(a.IsComplete(), Equals, true)
+}
@@ -1,15 +1,18 @@
 package wow
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
+	"hash"
 	"net/http"
 	"net/url"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -20,6 +23,85 @@ type ApiClient struct {
 	Locale    string
 	Secret    string
 	PublicKey string
+	// AccessToken, if set, authenticates requests with OAuth instead of
+	// signing them with Secret. See FetchAccessToken.
+	AccessToken string
+	// TokenURL overrides the region's OAuth token endpoint used by
+	// FetchAccessToken.
+	TokenURL string
+	// Scheme of API requests, "https" unless set. Plain "http" is only
+	// meant for tests.
+	Scheme string
+	// BasePath is the path API paths are relative to, "/wow/" unless
+	// set.
+	BasePath string
+	// Observer, if set, is notified of events such as deprecation
+	// warnings from the API.
+	Observer Observer
+	// TrackDeprecations makes the client remember deprecation warnings
+	// for later retrieval with Deprecations.
+	TrackDeprecations bool
+	// ResolveConnectedRealms makes GetAuctionData look up the connected
+	// realm group of the realm it's given and fetch the group's
+	// auctions via its primary realm. Connected realms share one
+	// auction house.
+	ResolveConnectedRealms bool
+	// SignatureHash is the hash used to sign requests. Defaults to
+	// sha1.New.
+	SignatureHash func() hash.Hash
+	// HttpClient sends the client's requests. NewApiClient sets it to
+	// an *http.Client with a timeout of DefaultTimeout, reused for every
+	// request so connections are kept alive. Auction file downloads
+	// lift an *http.Client's timeout and are bounded by
+	// DefaultDownloadTimeout instead (see WithDownloadTimeout). Replace
+	// it to use a custom transport or proxy.
+	HttpClient Doer
+	// DoerFor, if set, picks the Doer for each request from its API
+	// path (e.g. "item/18803", or AuctionFilesEndpoint for auction file
+	// downloads), so large transfers can use their own transport.
+	// Returning nil falls back to HttpClient.
+	DoerFor func(path string) Doer
+	// RetryIncompleteCharacters makes GetCharacterWithFields request a
+	// character once more if the response ends before the document
+	// does, which the character endpoint intermittently does.
+	RetryIncompleteCharacters bool
+	// AuctionFilesHost, if set, replaces the host of auction file URLs,
+	// keeping their path, so downloads go through e.g. a caching proxy.
+	AuctionFilesHost string
+	// MaxAuctionBytes, if positive, caps the size of auction files
+	// GetAuctions and StreamAuctions will download, after any
+	// decompression. Larger files fail with ErrAuctionTooLarge.
+	MaxAuctionBytes int64
+	// MaxRetries is how many times a request is retried when it's
+	// rate limited (429) or gets a 500, 502, 503 or 504, waiting as
+	// long as a Retry-After header asks or else backing off
+	// exponentially from RetryBackoff.
+	MaxRetries   int
+	RetryBackoff time.Duration
+	// MaxRetryAfter is the longest Retry-After the client waits for.
+	// Requests asked to wait longer fail with their ApiError instead.
+	// Zero means DefaultMaxRetryAfter.
+	MaxRetryAfter time.Duration
+	// Limiter, if set, is waited on before each API request (including
+	// retries), so a client shared between goroutines stays within a
+	// request budget. Auction file downloads aren't limited.
+	Limiter Limiter
+	// ETagCache, if set, stores responses that have an ETag, and later
+	// requests for the same URL are sent with If-None-Match so an
+	// unchanged resource is answered with 304 Not Modified and read
+	// from the cache.
+	ETagCache ETagCache
+	// GenerateRequestIds makes the client send a random X-Request-ID
+	// with each API request that doesn't have one from its context
+	// (see WithContextRequestId). Ids are reported to the Observer and
+	// on ApiErrors.
+	GenerateRequestIds bool
+
+	region                 string
+	validLocales           []string
+	defaultCharacterFields []string
+	ctx                    context.Context
+	shared                 *clientState
 }
 
 var apiClient *ApiClient = nil
@@ -55,22 +137,103 @@ func NewApiClient(region string, locale string) (*ApiClient, error) {
 		return nil, errors.New(fmt.Sprintf("Region '%s' is not valid", region))
 	}
 
-	var client *ApiClient
-	if locale == "" {
-		client = &ApiClient{Host: host, Locale: validLocales[0]}
-	} else {
-		for _, valid := range validLocales {
-			if valid == locale {
-				client = &ApiClient{Host: host, Locale: locale}
-			}
+	client := &ApiClient{
+		Host:          host,
+		Scheme:        "https",
+		HttpClient:    &http.Client{Timeout: DefaultTimeout},
+		MaxRetries:    DefaultMaxRetries,
+		RetryBackoff:  DefaultRetryBackoff,
+		MaxRetryAfter: DefaultMaxRetryAfter,
+		Locale:        validLocales[0],
+		region:        region,
+		validLocales:  validLocales,
+		shared:        newClientState(),
+	}
+	if locale != "" {
+		err := client.validateLocale(locale)
+		if err != nil {
+			return nil, err
 		}
+		client.Locale = locale
+	}
+	apiClient = client
+	return client, nil
+}
+
+// regionCode returns the short code ("US", "EU", ...) of a region as
+// given to NewApiClient, which also accepts names like "Europe".
+func regionCode(region string) string {
+	switch region {
+	case "United States":
+		return "US"
+	case "Europe":
+		return "EU"
+	case "Korea":
+		return "KR"
+	case "Taiwan":
+		return "TW"
+	case "CN", "China":
+		return "ZH"
+	}
+	return region
+}
+
+// Environment variables read by NewApiClientFromEnv.
+const (
+	PublicKeyEnv = "BNET_PUBLIC_KEY"
+	SecretEnv    = "BNET_SECRET"
+)
+
+// NewApiClientFromEnv is NewApiClient with the client's PublicKey and
+// Secret read from the BNET_PUBLIC_KEY and BNET_SECRET environment
+// variables, which must both be set.
+func NewApiClientFromEnv(region string, locale string) (*ApiClient, error) {
+	publicKey := os.Getenv(PublicKeyEnv)
+	secret := os.Getenv(SecretEnv)
+	missing := make([]string, 0)
+	if publicKey == "" {
+		missing = append(missing, PublicKeyEnv)
+	}
+	if secret == "" {
+		missing = append(missing, SecretEnv)
+	}
+	if len(missing) > 0 {
+		return nil, errors.New(fmt.Sprintf("Missing environment variables: %s", strings.Join(missing, ", ")))
+	}
+
+	client, err := NewApiClient(region, locale)
+	if err != nil {
+		return nil, err
 	}
-	if client != nil {
-		apiClient = client
-		return client, nil
+	client.PublicKey = publicKey
+	client.Secret = secret
+	return client, nil
+}
+
+// SetLocale changes the client's locale, which must be valid for its
+// region.
+func (a *ApiClient) SetLocale(locale string) error {
+	err := a.validateLocale(locale)
+	if err != nil {
+		return err
 	}
+	a.Locale = locale
+	return nil
+}
 
-	return nil, errors.New(fmt.Sprintf("Locale '%s' is not valid for region '%s'", locale, region))
+// validateLocale checks locale against the locales of the client's
+// region. Clients not created with NewApiClient don't know their
+// region, so any locale is accepted.
+func (a *ApiClient) validateLocale(locale string) error {
+	if len(a.validLocales) == 0 {
+		return nil
+	}
+	for _, valid := range a.validLocales {
+		if valid == locale {
+			return nil
+		}
+	}
+	return errors.New(fmt.Sprintf("Locale '%s' is not valid for region '%s'", locale, a.region))
 }
 
 func (a *ApiClient) GetAchievement(id int) (*Achievement, error) {
@@ -87,12 +250,19 @@ func (a *ApiClient) GetAchievement(id int) (*Achievement, error) {
 }
 
 func (a *ApiClient) GetAuctionData(realm string) (*AuctionData, error) {
+	if a.ResolveConnectedRealms {
+		connected, err := a.GetConnectedRealms(realm)
+		if err != nil {
+			return nil, err
+		}
+		realm = connected[0]
+	}
 	jsonBlob, err := a.get(fmt.Sprintf("auction/data/%s", realm))
 	if err != nil {
 		return nil, err
 	}
 	auctionData := &AuctionData{}
-	err = json.Unmarshal(jsonBlob, auctionData)
+	err = decodeJson(jsonBlob, auctionData)
 	if err != nil {
 		return nil, err
 	}
@@ -171,25 +341,93 @@ func (a *ApiClient) GetChallenge(realm string) ([]*Challenge, error) {
 	return a.GetChallenges(realm)
 }
 
+// GetCharacter fetches the character with the client's default fields
+// (see SetDefaultCharacterFields). Use GetCharacterWithFields to
+// request other fields.
 func (a *ApiClient) GetCharacter(realm string, characterName string) (*Character, error) {
-	return a.GetCharacterWithFields(realm, characterName, make([]string, 0))
+	return a.GetCharacterWithFields(realm, characterName, a.defaultCharacterFields)
 }
 
-func (a *ApiClient) GetCharacterWithFields(realm string, characterName string, fields []string) (*Character, error) {
-	err := validateCharacterFields(fields)
+// SetDefaultCharacterFields sets the fields GetCharacter requests. It
+// returns an error, leaving the defaults unchanged, if any field isn't
+// valid.
+func (a *ApiClient) SetDefaultCharacterFields(fields []string) error {
+	fields, err := validateCharacterFields(fields)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	jsonBlob, err := a.getWithParams(fmt.Sprintf("character/%s/%s", realm, characterName), map[string]string{"fields": strings.Join(fields, ",")})
+	a.defaultCharacterFields = fields
+	return nil
+}
 
+// GetCharacterWithFields fetches the character with the given extra
+// fields. opts can ask for more, e.g. WithWarnings.
+func (a *ApiClient) GetCharacterWithFields(realm string, characterName string, fields []string, opts ...CharacterOption) (*Character, error) {
+	char, jsonBlob, err := a.getCharacterWithFields(realm, characterName, fields)
 	if err != nil {
 		return nil, err
 	}
+	var options characterOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.warnings != nil {
+		*options.warnings, err = characterWarnings(jsonBlob, fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return char, nil
+}
+
+// getCharacterWithFields is GetCharacterWithFields that also returns
+// the response body.
+func (a *ApiClient) getCharacterWithFields(realm string, characterName string, fields []string) (*Character, []byte, error) {
+	fields, err := validateCharacterFields(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("character/%s/%s", realm, characterName)
+	params := map[string]string{"fields": strings.Join(fields, ",")}
+	jsonBlob, err := a.getWithParams(path, params)
+	if err == nil && a.RetryIncompleteCharacters && isIncompleteJson(jsonBlob) {
+		jsonBlob, err = a.getWithParams(path, params)
+	}
+	if err != nil {
+		return nil, nil, characterError(realm, characterName, err)
+	}
 	char := NewCharacter(a)
-	err = json.Unmarshal(jsonBlob, char)
+	err = decodeJson(jsonBlob, char)
+	if err != nil {
+		return nil, nil, err
+	}
+	return char, jsonBlob, nil
+}
+
+// GetCharacterItems fetches the character's equipped items, including
+// each item's tooltip params (enchants, gems, transmog, set pieces and
+// so on).
+func (a *ApiClient) GetCharacterItems(realm string, characterName string) (*ItemList, error) {
+	char, err := a.GetCharacterWithFields(realm, characterName, []string{"items"})
+	if err != nil {
+		return nil, err
+	}
+	if char.Items == nil {
+		return &ItemList{}, nil
+	}
+	return char.Items, nil
+}
+
+// GetCharacterAtLeast fetches the character's base profile and returns
+// an *ErrBelowLevel if the character is below minLevel.
+func (a *ApiClient) GetCharacterAtLeast(realm string, characterName string, minLevel int) (*Character, error) {
+	char, err := a.GetCharacterWithFields(realm, characterName, nil)
 	if err != nil {
 		return nil, err
 	}
+	if char.Level < minLevel {
+		return nil, &ErrBelowLevel{Name: char.Name, Level: char.Level, MinLevel: minLevel}
+	}
 	return char, nil
 }
 
@@ -206,6 +444,38 @@ func (a *ApiClient) GetItem(id int) (*Item, error) {
 	return item, err
 }
 
+// GetItemWithBonuses returns the item as modified by the given bonus
+// lists, e.g. for heroic or warforged versions of an item.
+func (a *ApiClient) GetItemWithBonuses(id int, bonusLists []int) (*Item, error) {
+	jsonBlob, err := a.getWithParams(fmt.Sprintf("item/%d", id), bonusListParams(bonusLists))
+	if err != nil {
+		return nil, err
+	}
+	return NewItemFromJson(jsonBlob)
+}
+
+func bonusListParams(bonusLists []int) map[string]string {
+	params := make(map[string]string)
+	if len(bonusLists) > 0 {
+		bl := make([]string, len(bonusLists))
+		for i, b := range bonusLists {
+			bl[i] = strconv.Itoa(b)
+		}
+		params["bl"] = strings.Join(bl, ",")
+	}
+	return params
+}
+
+// GetItemWithContext fetches the item as it drops in context, one of
+// its AvailableContexts, e.g. "raid-heroic".
+func (a *ApiClient) GetItemWithContext(id int, context string) (*Item, error) {
+	jsonBlob, err := a.get(fmt.Sprintf("item/%d/%s", id, context))
+	if err != nil {
+		return nil, err
+	}
+	return NewItemFromJson(jsonBlob)
+}
+
 func (a *ApiClient) GetItemSet(id int) (*ItemSet, error) {
 	jsonBlob, err := a.get(fmt.Sprintf("item/set/%d", id))
 	if err != nil {
@@ -225,11 +495,11 @@ func (a *ApiClient) GetGuild(realm string, guildName string) (*Guild, error) {
 }
 
 func (a *ApiClient) GetGuildWithFields(realm string, guildName string, fields []string) (*Guild, error) {
-	err := validateGuildFields(fields)
+	fields, err := validateGuildFields(fields)
 	if err != nil {
 		return nil, err
 	}
-	jsonBlob, err := a.getWithParams(fmt.Sprintf("guild/%s/%s", realm, url.QueryEscape(guildName)), map[string]string{"fields": strings.Join(fields, ",")})
+	jsonBlob, err := a.getWithParams(fmt.Sprintf("guild/%s/%s", realm, guildName), map[string]string{"fields": strings.Join(fields, ",")})
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +511,17 @@ func (a *ApiClient) GetGuildWithFields(realm string, guildName string, fields []
 	return guild, nil
 }
 
+// GetPvPLeaderboard returns the rated ladder of bracket, one of
+// LeaderboardBrackets.
 func (a *ApiClient) GetPvPLeaderboard(bracket string) ([]*PvPLeaderboardRow, error) {
+	err := validateLeaderboardBracket(bracket)
+	if err != nil {
+		return nil, err
+	}
 	jsonBlob, err := a.get(fmt.Sprintf("leaderboard/%s", bracket))
+	if err != nil {
+		return nil, err
+	}
 
 	leaderboard := &pvpLeaderboard{}
 	err = json.Unmarshal(jsonBlob, leaderboard)
@@ -254,6 +533,9 @@ func (a *ApiClient) GetPvPLeaderboard(bracket string) ([]*PvPLeaderboardRow, err
 
 func (a *ApiClient) GetQuest(id int) (*Quest, error) {
 	jsonBlob, err := a.get(fmt.Sprintf("quest/%d", id))
+	if err != nil {
+		return nil, err
+	}
 
 	quest := &Quest{}
 	err = json.Unmarshal(jsonBlob, quest)
@@ -264,7 +546,20 @@ func (a *ApiClient) GetQuest(id int) (*Quest, error) {
 }
 
 func (a *ApiClient) GetRealmStatus() ([]*RealmStatus, error) {
-	jsonBlob, err := a.get("realm/status")
+	return a.GetRealmStatusFiltered(nil)
+}
+
+// GetRealmStatusFiltered returns the status of the realms with the
+// given slugs only. No slugs returns every realm, like GetRealmStatus.
+func (a *ApiClient) GetRealmStatusFiltered(realms []string) ([]*RealmStatus, error) {
+	params := make(map[string]string)
+	if len(realms) > 0 {
+		params["realms"] = strings.Join(realms, ",")
+	}
+	jsonBlob, err := a.getWithParams("realm/status", params)
+	if err != nil {
+		return nil, err
+	}
 
 	list := &realmStatusList{}
 	err = json.Unmarshal(jsonBlob, list)
@@ -274,8 +569,94 @@ func (a *ApiClient) GetRealmStatus() ([]*RealmStatus, error) {
 	return list.Realms, nil
 }
 
+// GetRealmsByBattlegroup returns the realms in the battlegroup with
+// the given slug, as listed by the battlegroups data resource.
+func (a *ApiClient) GetRealmsByBattlegroup(slug string) ([]*Realm, error) {
+	battlegroups, err := a.GetBattlegroups()
+	if err != nil {
+		return nil, err
+	}
+	var battlegroup *Battlegroup
+	for _, b := range battlegroups {
+		if b.Slug == slug {
+			battlegroup = b
+		}
+	}
+	if battlegroup == nil {
+		return nil, errors.New(fmt.Sprintf("Battlegroup '%s' does not exist", slug))
+	}
+
+	statuses, err := a.GetRealmStatus()
+	if err != nil {
+		return nil, err
+	}
+	realms := make([]*Realm, 0)
+	for _, status := range statuses {
+		if status.Battlegroup == battlegroup.Name {
+			realms = append(realms, status.Realm())
+		}
+	}
+	return realms, nil
+}
+
+// GetConnectedRealms returns the slugs of the realms connected to the
+// realm with the given slug. The first slug is the group's primary
+// realm. A realm that isn't connected to any others is returned on its
+// own.
+func (a *ApiClient) GetConnectedRealms(slug string) ([]string, error) {
+	statuses, err := a.GetRealmStatus()
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		if status.Slug == slug {
+			if len(status.ConnectedRealms) == 0 {
+				return []string{status.Slug}, nil
+			}
+			return status.ConnectedRealms, nil
+		}
+	}
+	return nil, errors.New(fmt.Sprintf("Realm '%s' does not exist", slug))
+}
+
+// GetRealmGroups returns every realm, grouped by connected realm group.
+// Groups are keyed by the slug of their primary realm (see
+// RealmStatus.PrimaryRealm); realms that aren't connected form a group
+// of their own.
+func (a *ApiClient) GetRealmGroups() (map[string][]*Realm, error) {
+	statuses, err := a.GetRealmStatus()
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[string][]*Realm)
+	for _, status := range statuses {
+		primary := status.PrimaryRealm()
+		groups[primary] = append(groups[primary], status.Realm())
+	}
+	return groups, nil
+}
+
+// IsRealmOnline reports whether the realm is up. It accepts a realm
+// name or slug.
+func (a *ApiClient) IsRealmOnline(realm string) (bool, error) {
+	slug := RealmSlug(realm)
+	statuses, err := a.GetRealmStatus()
+	if err != nil {
+		return false, err
+	}
+	for _, status := range statuses {
+		if status.Slug == slug {
+			return status.Status, nil
+		}
+	}
+	return false, errors.New(fmt.Sprintf("Realm '%s' does not exist", slug))
+}
+
 func (a *ApiClient) GetRecipe(id int) (*Recipe, error) {
 	jsonBlob, err := a.get(fmt.Sprintf("recipe/%d", id))
+	if err != nil {
+		return nil, err
+	}
 
 	recipe := &Recipe{}
 	err = json.Unmarshal(jsonBlob, recipe)
@@ -287,6 +668,9 @@ func (a *ApiClient) GetRecipe(id int) (*Recipe, error) {
 
 func (a *ApiClient) GetSpell(id int) (*Spell, error) {
 	jsonBlob, err := a.get(fmt.Sprintf("spell/%d", id))
+	if err != nil {
+		return nil, err
+	}
 
 	spell := &Spell{}
 	err = json.Unmarshal(jsonBlob, spell)
@@ -298,6 +682,9 @@ func (a *ApiClient) GetSpell(id int) (*Spell, error) {
 
 func (a *ApiClient) GetBattlegroups() ([]*Battlegroup, error) {
 	jsonBlob, err := a.get("data/battlegroups/")
+	if err != nil {
+		return nil, err
+	}
 
 	battlegroupList := &battlegroupList{}
 	err = json.Unmarshal(jsonBlob, battlegroupList)
@@ -308,10 +695,15 @@ func (a *ApiClient) GetBattlegroups() ([]*Battlegroup, error) {
 }
 
 func (a *ApiClient) GetRaces() ([]*Race, error) {
-	jsonBlob, err := a.get("data/character/races")
+	return a.GetRacesWithLocale("")
+}
 
+// GetRacesWithLocale returns the races localized for locale, which
+// must be valid for the client's region. An empty locale uses the
+// client's locale. Results are cached per locale.
+func (a *ApiClient) GetRacesWithLocale(locale string) ([]*Race, error) {
 	raceList := &raceList{}
-	err = json.Unmarshal(jsonBlob, raceList)
+	err := a.getCached("data/character/races", map[string]string{"locale": locale}, raceList)
 	if err != nil {
 		return nil, err
 	}
@@ -319,10 +711,14 @@ func (a *ApiClient) GetRaces() ([]*Race, error) {
 }
 
 func (a *ApiClient) GetClasses() ([]*Class, error) {
-	jsonBlob, err := a.get("data/character/classes")
+	return a.GetClassesWithLocale("")
+}
 
+// GetClassesWithLocale returns the classes localized for locale. See
+// GetRacesWithLocale.
+func (a *ApiClient) GetClassesWithLocale(locale string) ([]*Class, error) {
 	classList := &classList{}
-	err = json.Unmarshal(jsonBlob, classList)
+	err := a.getCached("data/character/classes", map[string]string{"locale": locale}, classList)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +727,9 @@ func (a *ApiClient) GetClasses() ([]*Class, error) {
 
 func (a *ApiClient) GetAchievements() ([]*Achievement, error) {
 	jsonBlob, err := a.get("data/character/achievements")
+	if err != nil {
+		return nil, err
+	}
 
 	achievementList := &achievementData{}
 	err = json.Unmarshal(jsonBlob, achievementList)
@@ -342,6 +741,9 @@ func (a *ApiClient) GetAchievements() ([]*Achievement, error) {
 
 func (a *ApiClient) GetGuildRewards() ([]*GuildReward, error) {
 	jsonBlob, err := a.get("data/guild/rewards")
+	if err != nil {
+		return nil, err
+	}
 
 	guildRewardList := &guildRewardList{}
 	err = json.Unmarshal(jsonBlob, guildRewardList)
@@ -353,6 +755,9 @@ func (a *ApiClient) GetGuildRewards() ([]*GuildReward, error) {
 
 func (a *ApiClient) GetGuildPerks() ([]*GuildPerk, error) {
 	jsonBlob, err := a.get("data/guild/perks")
+	if err != nil {
+		return nil, err
+	}
 
 	guildPerkList := &guildPerkList{}
 	err = json.Unmarshal(jsonBlob, guildPerkList)
@@ -364,6 +769,9 @@ func (a *ApiClient) GetGuildPerks() ([]*GuildPerk, error) {
 
 func (a *ApiClient) GetGuildAchievements() ([]*Achievement, error) {
 	jsonBlob, err := a.get("data/guild/achievements")
+	if err != nil {
+		return nil, err
+	}
 
 	guildAchievementList := &guildAchievementList{}
 	err = json.Unmarshal(jsonBlob, guildAchievementList)
@@ -374,29 +782,65 @@ func (a *ApiClient) GetGuildAchievements() ([]*Achievement, error) {
 }
 
 func (a *ApiClient) GetItemClasses() ([]*ItemClass, error) {
-	jsonBlob, err := a.get("data/item/classes")
+	return a.GetItemClassesWithLocale("")
+}
 
+// GetItemClassesWithLocale returns the item classes localized for
+// locale. See GetRacesWithLocale.
+func (a *ApiClient) GetItemClassesWithLocale(locale string) ([]*ItemClass, error) {
 	itemClassList := &itemClassList{}
-	err = json.Unmarshal(jsonBlob, itemClassList)
+	err := a.getCached("data/item/classes", map[string]string{"locale": locale}, itemClassList)
 	if err != nil {
 		return nil, err
 	}
 	return itemClassList.Classes, nil
 }
 
-func (a *ApiClient) GetTalents() (*ClassTalentList, error) {
+// GetTalents returns the talents of every class, keyed by class id
+// string as the API keys them; ClassTalentList.ByClassId gives them
+// keyed by int, and GetClassTalents fetches a single class.
+func (a *ApiClient) GetTalents() (ClassTalentList, error) {
 	jsonBlob, err := a.get("data/talents")
+	if err != nil {
+		return nil, err
+	}
 
-	talents := &ClassTalentList{}
-	err = json.Unmarshal(jsonBlob, talents)
+	talents := make(ClassTalentList)
+	err = json.Unmarshal(jsonBlob, &talents)
 	if err != nil {
 		return nil, err
 	}
 	return talents, nil
 }
 
+// GetSpecRoles returns the role of every specialization, keyed by spec
+// id (see SpecId). The talents data resource it's built from is
+// cached.
+func (a *ApiClient) GetSpecRoles() (map[int]Role, error) {
+	talents := make(ClassTalentList)
+	err := a.getCached("data/talents", nil, &talents)
+	if err != nil {
+		return nil, err
+	}
+	roles := make(map[int]Role)
+	for classId, list := range talents.ByClassId() {
+		if list == nil {
+			continue
+		}
+		for _, spec := range list.Specs {
+			if id, ok := SpecId(classId, spec.Order); ok {
+				roles[id] = ParseRole(spec.Role)
+			}
+		}
+	}
+	return roles, nil
+}
+
 func (a *ApiClient) GetPetTypes() ([]*PetType, error) {
 	jsonBlob, err := a.get("data/pet/types")
+	if err != nil {
+		return nil, err
+	}
 
 	petTypes := &petTypeList{}
 	err = json.Unmarshal(jsonBlob, petTypes)
@@ -406,7 +850,83 @@ func (a *ApiClient) GetPetTypes() ([]*PetType, error) {
 	return petTypes.PetTypes, nil
 }
 
-func validateGuildFields(fields []string) error {
+// GetPets returns the master list of companion pets. The list lives at
+// pet/ rather than under data/, but is cached like a data resource.
+func (a *ApiClient) GetPets() ([]*CompanionPet, error) {
+	petList := &companionPetList{}
+	err := a.getCached("pet/", nil, petList)
+	if err != nil {
+		return nil, err
+	}
+	return petList.Pets, nil
+}
+
+// GetMounts returns the master list of mounts. Like the pet master
+// list it lives outside data/ and is cached like a data resource.
+func (a *ApiClient) GetMounts() ([]*Mount, error) {
+	mountList := &mountMasterList{}
+	err := a.getCached("mount/", nil, mountList)
+	if err != nil {
+		return nil, err
+	}
+	return mountList.Mounts, nil
+}
+
+// getCached decodes the resource at path into v. It's meant for public
+// resources that don't change, such as data resources: requests aren't
+// signed, and response bodies are cached per path and query params
+// (including the locale) so later calls are decoded without a request. A "locale" param
+// overrides the client's locale and must be valid for its region.
+func (a *ApiClient) getCached(path string, queryParams map[string]string, v interface{}) error {
+	jsonBlob, err := a.getCachedBlob(path, queryParams)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonBlob, v)
+}
+
+func (a *ApiClient) getCachedBlob(path string, queryParams map[string]string) ([]byte, error) {
+	params := url.Values{}
+	for k, v := range queryParams {
+		params.Set(k, v)
+	}
+	if params.Get("locale") == "" {
+		params.Set("locale", a.locale())
+	}
+	err := a.validateLocale(params.Get("locale"))
+	if err != nil {
+		return nil, err
+	}
+
+	key := path + "?" + params.Encode()
+	cached, ok := a.state().cache.get(key)
+	if a.Observer != nil {
+		eventType := CacheMissEvent
+		if ok {
+			eventType = CacheHitEvent
+		}
+		a.notify(&Event{Type: eventType, Path: path, Message: key})
+	}
+	if ok {
+		return cached.([]byte), nil
+	}
+
+	requestParams := make(map[string]string)
+	for k := range params {
+		requestParams[k] = params.Get(k)
+	}
+	jsonBlob, err := a.getPublic(path, requestParams)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(jsonBlob) {
+		return nil, errors.New(fmt.Sprintf("Response for '%s' is not valid JSON", path))
+	}
+	a.state().cache.set(key, jsonBlob)
+	return jsonBlob, nil
+}
+
+func validateGuildFields(fields []string) ([]string, error) {
 	validFields := []string{
 		"members",
 		"achievements",
@@ -415,7 +935,7 @@ func validateGuildFields(fields []string) error {
 	return validateFields(validFields, fields)
 }
 
-func validateCharacterFields(fields []string) error {
+func validateCharacterFields(fields []string) ([]string, error) {
 	validFields := []string{
 		"achievements",
 		"appearance",
@@ -437,7 +957,10 @@ func validateCharacterFields(fields []string) error {
 	return validateFields(validFields, fields)
 }
 
-func validateFields(validFields []string, fields []string) error {
+// validateFields checks fields against validFields and returns them
+// sorted and without duplicates, so the same set of fields always
+// produces the same URL.
+func validateFields(validFields []string, fields []string) ([]string, error) {
 	badFields := make([]string, 0)
 	var exists bool
 	for _, field := range fields {
@@ -452,10 +975,19 @@ func validateFields(validFields []string, fields []string) error {
 		}
 	}
 	if len(badFields) != 0 {
-		return errors.New(fmt.Sprintf("The following fields are not valid: %v", badFields))
-	} else {
-		return nil
+		return nil, errors.New(fmt.Sprintf("The following fields are not valid: %v", badFields))
+	}
+
+	seen := make(map[string]bool)
+	normalized := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !seen[field] {
+			seen[field] = true
+			normalized = append(normalized, field)
+		}
 	}
+	sort.Strings(normalized)
+	return normalized, nil
 }
 
 func (a *ApiClient) get(path string) ([]byte, error) {
@@ -463,75 +995,217 @@ func (a *ApiClient) get(path string) ([]byte, error) {
 }
 
 func (a *ApiClient) getWithParams(path string, queryParams map[string]string) ([]byte, error) {
-	client := &http.Client{}
-	var url *url.URL
-	var request *http.Request
-	var err error
-
-	if len(a.Secret) > 0 {
-		url = a.url(path, queryParams, true)
-		request, err = http.NewRequest("GET", url.String(), nil)
+	return a.fetch(path, queryParams, true)
+}
+
+// getPublic is getWithParams for endpoints that don't need
+// authentication. Its requests are never signed, but carry the
+// AccessToken if there is one, as OAuth requires it everywhere.
+func (a *ApiClient) getPublic(path string, queryParams map[string]string) ([]byte, error) {
+	return a.fetch(path, queryParams, false)
+}
+
+// fetch requests path from the API. Requests carry the client's
+// AccessToken if it has one; otherwise, if sign is set and the client
+// has a secret, the request is signed. Other requests send the
+// PublicKey as their apikey. Rate limited requests and
+// transient server errors are retried up to MaxRetries times.
+func (a *ApiClient) fetch(path string, queryParams map[string]string, sign bool) ([]byte, error) {
+	requestId := a.requestId()
+	for attempt := 0; ; attempt++ {
+		body, response, err := a.fetchOnce(path, queryParams, sign, requestId)
+		if err == nil || response == nil || !retryable(response.StatusCode) || attempt >= a.MaxRetries {
+			return body, err
+		}
+		delay, ok := a.retryDelay(attempt, response)
+		if !ok {
+			return body, err
+		}
+		err = a.sleep(delay)
 		if err != nil {
 			return make([]byte, 0), err
 		}
-	} else {
-		url = a.url(path, queryParams, false)
-		request, err = http.NewRequest("GET", url.String(), nil)
+	}
+}
+
+// fetchOnce makes a single attempt at fetch. It also returns the
+// response, if one was received, so fetch can decide whether to retry.
+func (a *ApiClient) fetchOnce(path string, queryParams map[string]string, sign bool, requestId string) ([]byte, *http.Response, error) {
+	if a.Limiter != nil {
+		err := a.Limiter.Wait(a.Context())
 		if err != nil {
-			return make([]byte, 0), err
+			return make([]byte, 0), nil, err
+		}
+	}
+	url := a.url(path, queryParams)
+	signed := a.AccessToken == "" && sign && len(a.Secret) > 0
+	request, err := http.NewRequestWithContext(a.Context(), "GET", a.withApiKey(url, signed).String(), nil)
+	if err != nil {
+		return make([]byte, 0), nil, err
+	}
+	if a.AccessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+a.AccessToken)
+	} else if signed {
+		// The signed date must be the one sent in the Date header.
+		date := time.Now().UTC().Format(http.TimeFormat)
+		signature, err := a.Sign("GET", path, date)
+		if err != nil {
+			return make([]byte, 0), nil, err
 		}
+		request.Header.Set("Date", date)
+		request.Header.Set("Authorization", a.authorizationString(signature))
 	}
 
-	response, err := client.Do(request)
+	if requestId != "" {
+		request.Header.Set(RequestIdHeader, requestId)
+	}
+
+	// Responses are cached by the URL without credentials (which
+	// withApiKey adds), so no key is written to the cache and entries
+	// survive key rotation.
+	cacheKey := url.String()
+	var cachedBody []byte
+	if a.ETagCache != nil {
+		etag, body, ok := a.ETagCache.Get(cacheKey)
+		if ok {
+			request.Header.Set("If-None-Match", etag)
+			cachedBody = body
+		}
+	}
+
+	response, err := a.doerFor(path).Do(request)
 	if err != nil {
-		return make([]byte, 0), err
+		return make([]byte, 0), nil, a.contextError(err)
 	}
 	defer response.Body.Close()
+	if requestId != "" {
+		a.notify(&Event{Type: RequestEvent, Path: path, Message: response.Status, RequestId: requestId})
+	}
+	a.checkDeprecation(path, response.Header)
+	a.setLastResponseHeaders(response.Header)
 
-	body, err := ioutil.ReadAll(response.Body)
+	if response.StatusCode == http.StatusNotModified && cachedBody != nil {
+		return cachedBody, response, nil
+	}
+	body, err := readBody(response)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		// A body that can't be read only costs the error its reason.
+		apiErr := newApiError(response, body)
+		apiErr.RequestId = requestId
+		return make([]byte, 0), response, apiErr
+	}
 	if err != nil {
-		return make([]byte, 0), err
+		return make([]byte, 0), response, a.contextError(err)
 	}
+	if etag := response.Header.Get("ETag"); a.ETagCache != nil && etag != "" {
+		a.ETagCache.Set(cacheKey, etag, body)
+	}
+
+	return body, response, nil
+}
 
-	return body, nil
+// LastResponseHeaders returns the headers of the most recent API
+// response, such as X-Plan-Qps-Allotted and X-Plan-Qps-Current, or nil
+// if no request has completed yet. When the client is shared between
+// goroutines, "most recent" is whichever response finished last.
+func (a *ApiClient) LastResponseHeaders() http.Header {
+	state := a.state()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	return state.lastHeaders
 }
 
-func (a *ApiClient) url(path string, queryParamPairs map[string]string, ssl bool) *url.URL {
-	queryParamPairs["locale"] = a.Locale
-	queryParamPairs["apikey"] = a.Secret
-	queryParamList := make([]string, 0)
+func (a *ApiClient) setLastResponseHeaders(header http.Header) {
+	state := a.state()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	state.lastHeaders = header.Clone()
+}
+
+func (a *ApiClient) url(path string, queryParamPairs map[string]string) *url.URL {
+	query := url.Values{}
 	for k, v := range queryParamPairs {
-		queryParamList = append(queryParamList, k+"="+v)
+		query.Set(k, v)
+	}
+	if _, ok := queryParamPairs["locale"]; !ok {
+		query.Set("locale", a.locale())
 	}
-	var scheme string
-	if ssl {
+	scheme := a.Scheme
+	if scheme == "" {
 		scheme = "https"
-	} else {
-		scheme = "http"
 	}
 	return &url.URL{
-		Scheme:   scheme,
-		Host:     a.Host,
-		Path:     "/wow/" + path,
-		RawQuery: strings.Join(queryParamList, "&"),
+		Scheme: scheme,
+		Host:   a.Host,
+		Path:   a.basePath() + path,
+		// Commas separate list values such as fields, so they're left
+		// unescaped.
+		RawQuery: strings.Replace(query.Encode(), "%2C", ",", -1),
 	}
 }
 
-func (a *ApiClient) authorizationString(signature string) string {
-	return fmt.Sprintf(" BNET %s:%s", a.PublicKey, signature)
+// withApiKey returns u with the client's PublicKey as its apikey param,
+// unless the request is authenticated by an AccessToken or signature.
+// The Secret is never sent.
+func (a *ApiClient) withApiKey(u *url.URL, signed bool) *url.URL {
+	if a.AccessToken != "" || signed || a.PublicKey == "" {
+		return u
+	}
+	keyed := *u
+	if keyed.RawQuery != "" {
+		keyed.RawQuery += "&"
+	}
+	keyed.RawQuery += "apikey=" + url.QueryEscape(a.PublicKey)
+	return &keyed
 }
 
-func (a *ApiClient) signature(verb string, path string) string {
-	url := a.url(path, make(map[string]string), true)
-	toBeSigned := []byte(strings.Join([]string{verb, time.Now().String(), url.Path, ""}, "\n"))
-	mac := hmac.New(sha1.New, []byte(a.Secret))
-	_, err := mac.Write(toBeSigned)
+func (a *ApiClient) basePath() string {
+	if a.BasePath == "" {
+		return "/wow/"
+	}
+	return a.BasePath
+}
+
+// SetBaseURL points the client at another server, such as a mock of
+// the API in tests, e.g. "http://127.0.0.1:8080/api/wow/". It sets
+// Scheme, Host and BasePath; the locale and credentials are kept.
+func (a *ApiClient) SetBaseURL(baseURL string) error {
+	u, err := url.Parse(baseURL)
 	if err != nil {
-		handleError(err)
+		return err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return errors.New(fmt.Sprintf("Base URL '%s' must include a scheme and host", baseURL))
 	}
-	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	a.Scheme = u.Scheme
+	a.Host = u.Host
+	a.BasePath = strings.TrimSuffix(u.Path, "/") + "/"
+	return nil
+}
+
+func (a *ApiClient) authorizationString(signature string) string {
+	return fmt.Sprintf("BNET %s:%s", a.PublicKey, signature)
+}
+
+// Sign returns the signature of a request for the API path (e.g.
+// "achievement/2144") sent at date, which must match the request's
+// Date header, e.g. "Fri, 01 Aug 2014 00:00:00 GMT". It's useful for
+// debugging authentication failures.
+func (a *ApiClient) Sign(verb string, path string, date string) (string, error) {
+	return a.sign(strings.Join([]string{verb, date, a.basePath() + path, ""}, "\n"))
 }
 
-func handleError(err error) {
-	panic(err)
+// sign returns the base64 encoded HMAC of toBeSigned, keyed with the
+// client's secret and using SignatureHash.
+func (a *ApiClient) sign(toBeSigned string) (string, error) {
+	hashFunc := a.SignatureHash
+	if hashFunc == nil {
+		hashFunc = sha1.New
+	}
+	mac := hmac.New(hashFunc, []byte(a.Secret))
+	_, err := mac.Write([]byte(toBeSigned))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
 }
@@ -1,20 +1,125 @@
 package wow
 
 import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
 	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 // GoCheck boilerplate
 func Test(t *testing.T) { TestingT(t) }
 
+// testClient returns a US client that sends its requests to server.
+func testClient(server *httptest.Server) *ApiClient {
+	return testRegionClient(server, "US", "")
+}
+
+// testRegionClient returns a client for region and locale that sends
+// its requests to server.
+func testRegionClient(server *httptest.Server, region string, locale string) *ApiClient {
+	client, err := NewApiClient(region, locale)
+	if err != nil {
+		panic(err)
+	}
+	client.Host = strings.TrimPrefix(server.URL, "http://")
+	client.Scheme = "http"
+	return client
+}
+
 type ApiClientSuite struct{}
 
 var _ = Suite(&ApiClientSuite{})
 
 func (s *ApiClientSuite) Test_signature(c *C) {
 	client, _ := NewApiClient("US", "")
-	c.Assert(client.signature("GET", "a/b/c"), Not(Equals), "")
+	signature, err := client.Sign("GET", "a/b/c", "Fri, 01 Aug 2014 00:00:00 GMT")
+	c.Assert(err, IsNil)
+	c.Assert(signature, Not(Equals), "")
+}
+
+func (s *ApiClientSuite) Test_signedRequest(c *C) {
+	var date, authorization string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		date = r.Header.Get("Date")
+		authorization = r.Header.Get("Authorization")
+		w.Write([]byte(`{"id": 13146}`))
+	}))
+	defer server.Close()
+	client, _ := NewApiClient("US", "")
+	client.Host = strings.TrimPrefix(server.URL, "https://")
+	client.HttpClient = server.Client()
+	client.Secret = "secret"
+	client.PublicKey = "public"
+
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	_, err = time.Parse(http.TimeFormat, date)
+	c.Assert(err, IsNil)
+	signature, _ := client.Sign("GET", "quest/13146", date)
+	c.Assert(authorization, Equals, "BNET public:"+signature)
+}
+
+func (s *ApiClientSuite) Test_apiKey(c *C) {
+	queries := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Write([]byte(`{"id": 13146, "races": []}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.Secret = "secret"
+	client.PublicKey = "public"
+
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	_, err = client.GetRaces()
+	c.Assert(err, IsNil)
+	client.AccessToken = "token"
+	_, err = client.GetClasses()
+	c.Assert(err, IsNil)
+	c.Assert(queries, DeepEquals, []string{"locale=en_US", "locale=en_US&apikey=public", "locale=en_US"})
+}
+
+func (s *ApiClientSuite) Test_sign(c *C) {
+	client, _ := NewApiClient("US", "")
+	client.Secret = "secret"
+	toBeSigned := "GET\nFri, 01 Aug 2014 00:00:00 GMT\n/wow/achievement/2144\n"
+	signature, err := client.sign(toBeSigned)
+	c.Assert(err, IsNil)
+	c.Assert(signature, Equals, "Zne+KwrdpuO0VucgsCTzt6Gex2U=")
+
+	client.SignatureHash = sha256.New
+	signature, err = client.sign(toBeSigned)
+	c.Assert(err, IsNil)
+	c.Assert(signature, Equals, "H8gx+0wEB6oXXM1i1OF/hhzB5Ch1RZE+UzxEDQS2ZMQ=")
+}
+
+// Golden signatures computed independently of the client.
+var signTests = []struct {
+	secret, verb, path, date, signature string
+}{
+	{"secret", "GET", "achievement/2144", "Fri, 01 Aug 2014 00:00:00 GMT", "Zne+KwrdpuO0VucgsCTzt6Gex2U="},
+	{"secret", "GET", "character/runetotem/Capoferro", "Mon, 02 Jun 2014 12:30:00 GMT", "jN8aEos+rCn1qW68cf1JiFQaCvA="},
+	{"another secret", "POST", "achievement/2144", "Fri, 01 Aug 2014 00:00:00 GMT", "taMDgLjdg1MldvFon1Wk67gNX10="},
+}
+
+func (s *ApiClientSuite) Test_Sign(c *C) {
+	client, _ := NewApiClient("US", "")
+	for _, test := range signTests {
+		client.Secret = test.secret
+		signature, err := client.Sign(test.verb, test.path, test.date)
+		c.Check(err, IsNil)
+		c.Check(signature, Equals, test.signature)
+	}
 }
 
 func (s *ApiClientSuite) Test_NewApiClient_default(c *C) {
@@ -346,9 +451,9 @@ func (s *ApiClientSuite) Test_GetTalents(c *C) {
 		println(err.Error())
 	}
 
-	c.Assert(a.Warrior, Not(IsNil))
-	c.Assert(len(a.Warrior.Glyphs) > 0, Equals, true)
-	c.Assert(a.Warrior.Talents[0][0], Not(IsNil))
+	c.Assert(a["1"], Not(IsNil))
+	c.Assert(len(a["1"].Glyphs) > 0, Equals, true)
+	c.Assert(a["1"].Talents[0][0], Not(IsNil))
 }
 
 func (s *ApiClientSuite) Test_GetPetTypes(c *C) {
@@ -361,3 +466,461 @@ func (s *ApiClientSuite) Test_GetPetTypes(c *C) {
 	c.Assert(len(a) > 0, Equals, true)
 }
 
+func (s *ApiClientSuite) Test_GetRaces_payload(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/data/character/races")
+		c.Check(r.URL.Query().Get("locale"), Equals, "pt_BR")
+		w.Write([]byte(`{"races": [{"id": 1, "mask": 1, "side": "alliance", "name": "Humano"}, {"id": 2, "mask": 2, "side": "horde", "name": "Orc"}]}`))
+	}))
+	defer server.Close()
+	client := testRegionClient(server, "US", "pt_BR")
+
+	a, err := client.GetRaces()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(*a[0], DeepEquals, Race{Id: 1, Mask: 1, Side: "alliance", Name: "Humano"})
+}
+
+func (s *ApiClientSuite) Test_GetClasses_payload(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/data/character/classes")
+		c.Check(r.URL.Query().Get("locale"), Equals, "en_US")
+		w.Write([]byte(`{"classes": [{"id": 3, "mask": 4, "powerType": "focus", "name": "Hunter"}, {"id": 8, "mask": 128, "powerType": "mana", "name": "Mage"}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	a, err := client.GetClasses()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(*a[1], DeepEquals, Class{Id: 8, Mask: 128, PowerType: "mana", Name: "Mage"})
+}
+
+func (s *ApiClientSuite) Test_GetGuildRewardsAndPerks_payload(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/data/guild/rewards":
+			w.Write([]byte(`{"rewards": [{"minGuildLevel": 0, "minGuildRepLevel": 5, "races": [2, 5, 6, 8, 10, 9, 26], "achievement": {"id": 5179, "title": "Horde Slayer"}, "item": {"id": 62799, "name": "Broken Pendant", "icon": "inv_misc_necklace_cataclysm"}}]}`))
+		case "/wow/data/guild/perks":
+			w.Write([]byte(`{"perks": [{"guildLevel": 1, "spell": {"id": 78631, "name": "Fast Track", "icon": "achievement_guildperk_fasttrack", "description": "Experience gained from killing monsters and completing quests increased by 5%."}}]}`))
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	rewards, err := client.GetGuildRewards()
+	c.Assert(err, IsNil)
+	c.Assert(len(rewards), Equals, 1)
+	c.Assert(rewards[0].MinGuildRepLevel, Equals, 5)
+	c.Assert(rewards[0].Item.Name, Equals, "Broken Pendant")
+	c.Assert(rewards[0].RacesMask(), Equals, 2|16|32|128|512|256|1<<25)
+	c.Assert((&GuildReward{}).RacesMask(), Equals, 0)
+
+	perks, err := client.GetGuildPerks()
+	c.Assert(err, IsNil)
+	c.Assert(len(perks), Equals, 1)
+	c.Assert(perks[0].GuildLevel, Equals, 1)
+	c.Assert(*perks[0].Spell, DeepEquals, Spell{Id: 78631, Name: "Fast Track", Icon: "achievement_guildperk_fasttrack", Description: "Experience gained from killing monsters and completing quests increased by 5%."})
+}
+
+func (s *ApiClientSuite) Test_GetItemClasses_payload(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/data/item/classes")
+		c.Check(r.URL.Query().Get("locale"), Equals, "en_US")
+		w.Write([]byte(`{"classes": [{"class": 0, "name": "Consumable", "subclasses": [{"subclass": 0, "name": "Explosives and Devices"}, {"subclass": 1, "name": "Potion"}]}, {"class": 2, "name": "Weapon", "subclasses": [{"subclass": 7, "name": "One-Handed Swords"}]}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	a, err := client.GetItemClasses()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 2)
+	c.Assert(a[1].Class, Equals, 2)
+	c.Assert(*a[1].Subclasses[0], DeepEquals, ItemSubclass{Subclass: 7, Name: "One-Handed Swords"})
+	c.Assert(a[0].SubclassNames(), DeepEquals, map[int]string{0: "Explosives and Devices", 1: "Potion"})
+}
+
+func (s *ApiClientSuite) Test_GetTalentsAndPetTypes_payload(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/data/talents":
+			w.Write([]byte(`{
+				"8": {"class": "mage", "talents": [[{"tier": 0, "column": 1, "spell": {"id": 205022, "name": "Arcane Familiar"}}]], "specs": [{"name": "Frost", "role": "DPS", "order": 2}]},
+				"10": {"class": "monk", "talents": [], "specs": [{"name": "Mistweaver", "role": "HEALING", "order": 1}]},
+				"12": {"class": "demon-hunter", "talents": [], "specs": [{"name": "Havoc", "role": "DPS", "order": 0}]}
+			}`))
+		case "/wow/data/pet/types":
+			w.Write([]byte(`{"petTypes": [{"id": 0, "key": "humanoid", "name": "Humanoid", "typeAbilityId": 238, "strongAgainstId": 1, "weakAgainstId": 5}]}`))
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	talents, err := client.GetTalents()
+	c.Assert(err, IsNil)
+	c.Assert(len(talents), Equals, 3)
+	c.Assert(talents["8"].Talents[0][0].Spell.Name, Equals, "Arcane Familiar")
+	c.Assert(talents["12"].Class, Equals, "demon-hunter")
+	byClass := talents.ByClassId()
+	c.Assert(byClass[10].Specs[0].Name, Equals, "Mistweaver")
+	c.Assert(byClass[12].Specs[0].Name, Equals, "Havoc")
+	c.Assert(byClass[1], IsNil)
+
+	petTypes, err := client.GetPetTypes()
+	c.Assert(err, IsNil)
+	c.Assert(len(petTypes), Equals, 1)
+	c.Assert(*petTypes[0], DeepEquals, PetType{Id: 0, Key: "humanoid", Name: "Humanoid", TypeAbilityId: 238, StrongAgainstId: 1, WeakAgainstId: 5})
+}
+
+func (s *ApiClientSuite) Test_GetClassesWithLocale_invalid(c *C) {
+	client, _ := NewApiClient("US", "")
+	_, err := client.GetClassesWithLocale("fr_FR")
+	c.Assert(err.Error(), Equals, "Locale 'fr_FR' is not valid for region 'US'")
+}
+
+func (s *ApiClientSuite) Test_GetClassesWithLocale_cached(c *C) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		name := "Warrior"
+		if r.URL.Query().Get("locale") == "es_MX" {
+			name = "Guerrero"
+		}
+		fmt.Fprintf(w, `{"classes": [{"id": 1, "mask": 1, "powerType": "rage", "name": "%s"}]}`, name)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	for i := 0; i < 2; i++ {
+		a, err := client.GetClasses()
+		c.Assert(err, IsNil)
+		c.Assert(a[0].Name, Equals, "Warrior")
+		a, err = client.GetClassesWithLocale("es_MX")
+		c.Assert(err, IsNil)
+		c.Assert(a[0].Name, Equals, "Guerrero")
+	}
+	c.Assert(requests, Equals, 2)
+}
+
+func (s *ApiClientSuite) Test_LastResponseHeaders(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Plan-Qps-Allotted", "100")
+		w.Header().Set("X-Plan-Qps-Current", "1")
+		w.Write([]byte(`{"id": 13146}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	c.Assert(client.LastResponseHeaders(), IsNil)
+
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(client.LastResponseHeaders().Get("X-Plan-Qps-Allotted"), Equals, "100")
+	c.Assert(client.LastResponseHeaders().Get("X-Plan-Qps-Current"), Equals, "1")
+}
+
+func (s *ApiClientSuite) Test_GetPets(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/pet/")
+		w.Write([]byte(`{"pets": [{"canBattle": true, "creatureId": 85009, "name": "Murkalot", "family": "humanoid", "icon": "inv_pet_murkalot", "qualityId": 1, "stats": {"speciesId": 1451, "breedId": 3, "petQualityId": 1, "level": 1, "health": 158, "power": 8, "speed": 8}, "strongAgainst": ["beast"], "typeId": 0, "weakAgainst": ["dragonkin"]}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	a, err := client.GetPets()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[0].Name, Equals, "Murkalot")
+	c.Assert(a[0].Stats.SpeciesId, Equals, 1451)
+	c.Assert(a[0].StrongAgainst, DeepEquals, []string{"beast"})
+}
+
+func (s *ApiClientSuite) Test_GetMounts(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/mount/")
+		w.Write([]byte(`{"mounts": [{"name": "Abyssal Seahorse", "spellId": 75207, "creatureId": 40054, "itemId": 0, "qualityId": 3, "icon": "ability_mount_seahorse", "isGround": true, "isFlying": false, "isAquatic": true, "isJumping": true}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	a, err := client.GetMounts()
+	c.Assert(err, IsNil)
+	c.Assert(len(a), Equals, 1)
+	c.Assert(*a[0], DeepEquals, Mount{Name: "Abyssal Seahorse", SpellId: 75207, CreatureId: 40054, QualityId: 3, Icon: "ability_mount_seahorse", IsGround: true, IsAquatic: true, IsJumping: true})
+}
+
+func (s *ApiClientSuite) Test_GetAuctionData_resolveConnectedRealms(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/realm/status":
+			w.Write([]byte(`{"realms": [{"name": "Runetotem", "slug": "runetotem", "connected_realms": ["nazgrel", "runetotem", "nesingwary"]}]}`))
+		case "/wow/auction/data/nazgrel":
+			w.Write([]byte(`{"files": [{"url": "http://example.com/auctions.json", "lastModified": 1400000000000}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.ResolveConnectedRealms = true
+
+	a, err := client.GetAuctionData("runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(a.Files[0].Url, Equals, "http://example.com/auctions.json")
+
+	_, err = client.GetAuctionData("notarealm")
+	c.Assert(err.Error(), Equals, "Realm 'notarealm' does not exist")
+}
+
+func (s *ApiClientSuite) Test_validateCharacterFields(c *C) {
+	fields, err := validateCharacterFields([]string{"titles", "items", "achievements", "items"})
+	c.Assert(err, IsNil)
+	c.Assert(fields, DeepEquals, []string{"achievements", "items", "titles"})
+
+	fields, err = validateCharacterFields([]string{})
+	c.Assert(err, IsNil)
+	c.Assert(fields, DeepEquals, []string{})
+}
+
+func (s *ApiClientSuite) Test_validateCharacterFields_invalid(c *C) {
+	_, err := validateCharacterFields([]string{"items", "bogus", "items"})
+	c.Assert(err.Error(), Equals, "The following fields are not valid: [bogus]")
+}
+
+func (s *ApiClientSuite) Test_GetCharacterWithFields_normalizedFields(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Query().Get("fields"), Equals, "guild,items")
+		w.Write([]byte(`{"name": "Capoferro"}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	_, err := client.GetCharacterWithFields("Runetotem", "Capoferro", []string{"items", "guild", "items"})
+	c.Assert(err, IsNil)
+}
+
+func (s *ApiClientSuite) Test_getPublic_unsigned(c *C) {
+	authorization := make(map[string]string)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization[r.URL.Path] = r.Header.Get("Authorization")
+		w.Write([]byte(`{"id": 13146, "classes": []}`))
+	}))
+	defer server.Close()
+	client, _ := NewApiClient("US", "")
+	client.Host = strings.TrimPrefix(server.URL, "https://")
+	client.HttpClient = server.Client()
+	client.Secret = "secret"
+	client.PublicKey = "public"
+
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	_, err = client.GetClasses()
+	c.Assert(err, IsNil)
+	c.Assert(authorization["/wow/quest/13146"], Matches, "BNET public:.+")
+	c.Assert(authorization["/wow/data/character/classes"], Equals, "")
+}
+
+func (s *ApiClientSuite) Test_IsRealmOnline(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"realms": [{"name": "Argent Dawn", "slug": "argent-dawn", "status": true}, {"name": "Runetotem", "slug": "runetotem", "status": false}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	online, err := client.IsRealmOnline("Argent Dawn")
+	c.Assert(err, IsNil)
+	c.Assert(online, Equals, true)
+	online, err = client.IsRealmOnline("runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(online, Equals, false)
+	_, err = client.IsRealmOnline("Not A Realm")
+	c.Assert(err.Error(), Equals, "Realm 'not-a-realm' does not exist")
+}
+
+func (s *ApiClientSuite) Test_SetDefaultCharacterFields(c *C) {
+	requested := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Query().Get("fields"))
+		w.Write([]byte(`{"name": "Capoferro"}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	err := client.SetDefaultCharacterFields([]string{"talents", "items"})
+	c.Assert(err, IsNil)
+	err = client.SetDefaultCharacterFields([]string{"items", "bogus"})
+	c.Assert(err.Error(), Equals, "The following fields are not valid: [bogus]")
+
+	_, err = client.GetCharacter("Runetotem", "Capoferro")
+	c.Assert(err, IsNil)
+	_, err = client.GetCharacterWithFields("Runetotem", "Capoferro", []string{"guild"})
+	c.Assert(err, IsNil)
+	_, err = client.GetCharacterAtLeast("Runetotem", "Capoferro", 0)
+	c.Assert(err, IsNil)
+	c.Assert(requested, DeepEquals, []string{"items,talents", "guild", ""})
+}
+
+func (s *ApiClientSuite) Test_NewApiClientFromEnv(c *C) {
+	defer os.Setenv(PublicKeyEnv, os.Getenv(PublicKeyEnv))
+	defer os.Setenv(SecretEnv, os.Getenv(SecretEnv))
+
+	os.Setenv(PublicKeyEnv, "")
+	os.Setenv(SecretEnv, "")
+	_, err := NewApiClientFromEnv("US", "")
+	c.Assert(err.Error(), Equals, "Missing environment variables: BNET_PUBLIC_KEY, BNET_SECRET")
+
+	os.Setenv(PublicKeyEnv, "public")
+	_, err = NewApiClientFromEnv("US", "")
+	c.Assert(err.Error(), Equals, "Missing environment variables: BNET_SECRET")
+
+	os.Setenv(SecretEnv, "secret")
+	client, err := NewApiClientFromEnv("EU", "fr_FR")
+	c.Assert(err, IsNil)
+	c.Assert(client.PublicKey, Equals, "public")
+	c.Assert(client.Secret, Equals, "secret")
+	c.Assert(client.Locale, Equals, "fr_FR")
+
+	_, err = NewApiClientFromEnv("EU", "en_US")
+	c.Assert(err.Error(), Equals, "Locale 'en_US' is not valid for region 'EU'")
+}
+
+func (s *ApiClientSuite) Test_GetRealmGroups(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"realms": [
+			{"name": "Runetotem", "slug": "runetotem", "connected_realms": ["nazgrel", "runetotem", "nesingwary"]},
+			{"name": "Nazgrel", "slug": "nazgrel", "connected_realms": ["nazgrel", "runetotem", "nesingwary"]},
+			{"name": "Nesingwary", "slug": "nesingwary", "connected_realms": ["nazgrel", "runetotem", "nesingwary"]},
+			{"name": "Aegwynn", "slug": "aegwynn", "connected_realms": ["aegwynn"]},
+			{"name": "Uther", "slug": "uther"}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	groups, err := client.GetRealmGroups()
+	c.Assert(err, IsNil)
+	c.Assert(len(groups), Equals, 3)
+	c.Assert(len(groups["nazgrel"]), Equals, 3)
+	c.Assert(groups["nazgrel"][0].Name, Equals, "Runetotem")
+	c.Assert(groups["aegwynn"][0].Slug, Equals, "aegwynn")
+	c.Assert(groups["uther"][0].Slug, Equals, "uther")
+}
+
+// failingHash is a hash.Hash whose writes fail.
+type failingHash struct {
+	hash.Hash
+}
+
+func (h failingHash) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func (s *ApiClientSuite) Test_signingError(c *C) {
+	requests := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+	client, _ := NewApiClient("US", "")
+	client.Host = strings.TrimPrefix(server.URL, "https://")
+	client.HttpClient = server.Client()
+	client.Secret = "secret"
+	client.SignatureHash = func() hash.Hash { return failingHash{sha1.New()} }
+
+	_, err := client.Sign("GET", "quest/13146", "Fri, 01 Aug 2014 00:00:00 GMT")
+	c.Assert(err, ErrorMatches, "write failed")
+	_, err = client.GetQuest(13146)
+	c.Assert(err, ErrorMatches, "write failed")
+	c.Assert(requests, Equals, 0)
+}
+
+func (s *ApiClientSuite) Test_https(c *C) {
+	var tls bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tls = r.TLS != nil
+		w.Write([]byte(`{"id": 13146}`))
+	}))
+	defer server.Close()
+	client, _ := NewApiClient("US", "")
+	c.Assert(client.url("quest/13146", map[string]string{}).Scheme, Equals, "https")
+	c.Assert((&ApiClient{Host: "us.battle.net"}).url("quest/13146", map[string]string{}).Scheme, Equals, "https")
+
+	client.Host = strings.TrimPrefix(server.URL, "https://")
+	client.HttpClient = server.Client()
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(tls, Equals, true)
+}
+
+func (s *ApiClientSuite) Test_url_encoding(c *C) {
+	client, _ := NewApiClient("EU", "fr_FR")
+	u := client.url("character/Argent Dawn/Capoferro", map[string]string{"fields": "items,guild", "name": "Kel'Thuzad & co"})
+	c.Assert(u.String(), Equals, "https://eu.battle.net/wow/character/Argent%20Dawn/Capoferro?fields=items,guild&locale=fr_FR&name=Kel%27Thuzad+%26+co")
+}
+
+func (s *ApiClientSuite) Test_GetCharacterWithFields_realmEncoding(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/character/Aman'Thul Argent Dawn/Capoferro")
+		c.Check(r.URL.Query().Get("fields"), Equals, "guild,items")
+		w.Write([]byte(`{"name": "Capoferro"}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	ch, err := client.GetCharacterWithFields("Aman'Thul Argent Dawn", "Capoferro", []string{"items", "guild"})
+	c.Assert(err, IsNil)
+	c.Assert(ch.Name, Equals, "Capoferro")
+}
+
+func (s *ApiClientSuite) Test_GetRealmStatusFiltered(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/realm/status")
+		c.Check(r.URL.Query().Get("realms"), Equals, "medivh,lightbringer")
+		w.Write([]byte(`{"realms": [
+			{"type": "pve", "population": "medium", "queue": false, "wintergrasp": {"area": 1, "controlling-faction": 0, "status": 0, "next": 1402165860000}, "tol-barad": {"area": 21, "controlling-faction": 1, "status": 0, "next": 1402165170000}, "status": true, "name": "Medivh", "slug": "medivh", "battlegroup": "Ruin", "locale": "en_US", "timezone": "America/New_York", "connected_realms": ["medivh", "exodar"]},
+			{"type": "pvp", "population": "high", "queue": true, "status": false, "name": "Lightbringer", "slug": "lightbringer", "battlegroup": "Cyclone", "locale": "en_US", "timezone": "America/Los_Angeles", "connected_realms": ["lightbringer"]}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	realms, err := client.GetRealmStatusFiltered([]string{"medivh", "lightbringer"})
+	c.Assert(err, IsNil)
+	c.Assert(len(realms), Equals, 2)
+	c.Assert(realms[0].Type, Equals, "pve")
+	c.Assert(realms[0].Population, Equals, "medium")
+	c.Assert(realms[0].Status, Equals, true)
+	c.Assert(realms[0].TolBarad.ControllingFaction, Equals, 1)
+	c.Assert(realms[0].ConnectedRealms, DeepEquals, []string{"medivh", "exodar"})
+	c.Assert(realms[1].Queue, Equals, true)
+	c.Assert(realms[1].Status, Equals, false)
+	c.Assert(realms[1].Battlegroup, Equals, "Cyclone")
+}
+
+func (s *ApiClientSuite) Test_SetBaseURL(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/api/wow/achievement/2144")
+		c.Check(r.URL.Query().Get("locale"), Equals, "es_MX")
+		w.Write([]byte(`{"id": 2144, "title": "What A Long, Strange Trip It's Been", "points": 50}`))
+	}))
+	defer server.Close()
+	client, _ := NewApiClient("US", "es_MX")
+
+	err := client.SetBaseURL(server.URL + "/api/wow")
+	c.Assert(err, IsNil)
+	c.Assert(client.BasePath, Equals, "/api/wow/")
+	a, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(a.Points, Equals, 50)
+
+	err = client.SetBaseURL("localhost/api/wow")
+	c.Assert(err.Error(), Equals, "Base URL 'localhost/api/wow' must include a scheme and host")
+	c.Assert(client.validateLocale("en_GB"), NotNil)
+}
+
+func (s *ApiClientSuite) Test_SetLocale(c *C) {
+	client, _ := NewApiClient("US", "")
+	c.Assert(client.SetLocale("pt_BR"), IsNil)
+	c.Assert(client.Locale, Equals, "pt_BR")
+
+	err := client.SetLocale("en_GB")
+	c.Assert(err.Error(), Equals, "Locale 'en_GB' is not valid for region 'US'")
+	c.Assert(client.Locale, Equals, "pt_BR")
+}
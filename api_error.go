@@ -0,0 +1,42 @@
+package wow
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ApiError is returned when the API responds with a status other than
+// 2xx. Use errors.As to tell, say, a missing character (404) from a
+// forbidden request (403).
+type ApiError struct {
+	StatusCode int
+	// Status is the HTTP status, e.g. "404 Not Found".
+	Status string
+	// Reason is the explanation the API gave, e.g. "Character not
+	// found.", if any.
+	Reason string
+	// RequestId is the X-Request-ID the request was sent with, if any.
+	RequestId string
+}
+
+func (e *ApiError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("API request failed: %s", e.Status)
+	}
+	return fmt.Sprintf("API request failed: %s: %s", e.Status, e.Reason)
+}
+
+// newApiError builds the error for a failed response from its body,
+// which is normally {"status": "nok", "reason": "..."}.
+func newApiError(response *http.Response, body []byte) *ApiError {
+	nok := &struct {
+		Reason string
+	}{}
+	json.Unmarshal(body, nok)
+	return &ApiError{
+		StatusCode: response.StatusCode,
+		Status:     response.Status,
+		Reason:     nok.Reason,
+	}
+}
@@ -0,0 +1,42 @@
+package wow
+
+import (
+	"errors"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type ApiErrorSuite struct{}
+
+var _ = Suite(&ApiErrorSuite{})
+
+func (s *ApiErrorSuite) Test_ApiError(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/wow/character/") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status": "nok", "reason": "Character not found."}`))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`<html>Internal Server Error</html>`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.MaxRetries = 0
+
+	_, err := client.GetCharacter("Runetotem", "Nobody")
+	var apiErr *ApiError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusNotFound)
+	c.Assert(apiErr.Status, Equals, "404 Not Found")
+	c.Assert(apiErr.Reason, Equals, "Character not found.")
+	c.Assert(err.Error(), Equals, "API request failed: 404 Not Found: Character not found.")
+
+	_, err = client.GetQuest(13146)
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusInternalServerError)
+	c.Assert(apiErr.Reason, Equals, "")
+	c.Assert(err.Error(), Equals, "API request failed: 500 Internal Server Error")
+}
@@ -10,3 +10,22 @@ type ArenaBracket struct {
 	SeasonWon    int
 	SeasonLost   int
 }
+
+// SeasonWinRate returns the fraction of this season's games won, or 0
+// if none were played.
+func (b *ArenaBracket) SeasonWinRate() float64 {
+	return winRate(b.SeasonWon, b.SeasonLost)
+}
+
+// WeeklyWinRate returns the fraction of this week's games won, or 0 if
+// none were played.
+func (b *ArenaBracket) WeeklyWinRate() float64 {
+	return winRate(b.WeeklyWon, b.WeeklyLost)
+}
+
+func winRate(won int, lost int) float64 {
+	if won+lost == 0 {
+		return 0
+	}
+	return float64(won) / float64(won+lost)
+}
@@ -0,0 +1,44 @@
+package wow
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Armory hosts per region.
+var armoryHosts = map[string]string{
+	"US": "worldofwarcraft.com",
+	"EU": "worldofwarcraft.com",
+	"KR": "worldofwarcraft.com",
+	"TW": "worldofwarcraft.com",
+	"ZH": "wow.blizzard.cn",
+}
+
+// ArmoryURL returns the link to the character's armory page in the
+// client's region and locale, e.g.
+// https://worldofwarcraft.com/en-us/character/us/runetotem/capoferro.
+// Clients not created with NewApiClient link to the US armory.
+func (a *ApiClient) ArmoryURL(c *Character) string {
+	return a.armoryURL("character", c.Realm, strings.ToLower(c.Name))
+}
+
+// GuildArmoryURL returns the link to the guild's armory page in the
+// client's region and locale.
+func (a *ApiClient) GuildArmoryURL(g *Guild) string {
+	return a.armoryURL("guild", g.Realm, strings.Join(strings.Fields(strings.ToLower(g.Name)), "-"))
+}
+
+func (a *ApiClient) armoryURL(kind string, realm string, slug string) string {
+	region := regionCode(a.region)
+	if _, ok := armoryHosts[region]; !ok {
+		region = "US"
+	}
+	locale := strings.ToLower(strings.Replace(a.locale(), "_", "-", 1))
+	regionPath := strings.ToLower(region)
+	if region == "ZH" {
+		regionPath = "cn"
+	}
+	return fmt.Sprintf("https://%s/%s/%s/%s/%s/%s", armoryHosts[region], locale, kind, regionPath,
+		url.PathEscape(RealmSlug(realm)), url.PathEscape(slug))
+}
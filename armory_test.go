@@ -0,0 +1,27 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type ArmorySuite struct{}
+
+var _ = Suite(&ArmorySuite{})
+
+func (s *ArmorySuite) Test_ArmoryURL(c *C) {
+	client, _ := NewApiClient("US", "")
+	c.Assert(client.ArmoryURL(&Character{Name: "Capoferro", Realm: "Runetotem"}), Equals,
+		"https://worldofwarcraft.com/en-us/character/us/runetotem/capoferro")
+	c.Assert(client.GuildArmoryURL(&Guild{Name: "Knights Who Say Ni", Realm: "Mal'Ganis"}), Equals,
+		"https://worldofwarcraft.com/en-us/guild/us/malganis/knights-who-say-ni")
+
+	client, _ = NewApiClient("Europe", "de_DE")
+	c.Assert(client.ArmoryURL(&Character{Name: "Ümläut", Realm: "Aggra (Português)"}), Equals,
+		"https://worldofwarcraft.com/de-de/character/eu/aggra-portugues/%C3%BCml%C3%A4ut")
+}
+
+func (s *ArmorySuite) Test_ArmoryURL_unknownRegion(c *C) {
+	client := &ApiClient{Locale: "en_US"}
+	c.Assert(client.ArmoryURL(&Character{Name: "Capoferro", Realm: "Runetotem"}), Equals,
+		"https://worldofwarcraft.com/en-us/character/us/runetotem/capoferro")
+}
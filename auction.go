@@ -0,0 +1,36 @@
+package wow
+
+// Auction is a single listing from an auction house dump. Bid and
+// Buyout are in copper and cover the whole stack; a Buyout of 0 means
+// the auction can't be bought out.
+type Auction struct {
+	Auc        int
+	Item       int
+	Owner      string
+	OwnerRealm string
+	Bid        int64
+	Buyout     int64
+	Quantity   int
+	TimeLeft   string
+	Rand       int
+	Seed       int
+	Context    int
+}
+
+// Seller identifies the auction's owner as "Name-Realm", or just the
+// name if the owner's realm isn't known.
+func (a *Auction) Seller() string {
+	if a.OwnerRealm == "" {
+		return a.Owner
+	}
+	return a.Owner + "-" + a.OwnerRealm
+}
+
+// UnitPrice returns the buyout price of a single item of the stack, in
+// copper, or 0 if the auction can't be bought out.
+func (a *Auction) UnitPrice() int64 {
+	if a.Buyout == 0 || a.Quantity < 1 {
+		return 0
+	}
+	return a.Buyout / int64(a.Quantity)
+}
@@ -0,0 +1,72 @@
+package wow
+
+import (
+	"sort"
+)
+
+// ArbitrageOpportunity is an item that can be bought on one realm for
+// less than it's listed for on another. Prices are the lowest unit
+// buyouts, in copper, on each realm.
+type ArbitrageOpportunity struct {
+	Item      int
+	BuyRealm  string
+	BuyPrice  int64
+	SellRealm string
+	SellPrice int64
+}
+
+// Profit returns the difference in unit price, before the auction
+// house's cut and deposit.
+func (o *ArbitrageOpportunity) Profit() int64 {
+	return o.SellPrice - o.BuyPrice
+}
+
+// Arbitrage compares the lowest unit buyout of item across snapshots,
+// which are keyed by realm (e.g. the primary realm of each connected
+// realm group), and returns every pair of realms where buying on one
+// and selling on the other is profitable, most profitable first.
+// Auctions without a buyout are ignored, as are realms not listing the
+// item.
+func Arbitrage(snapshots map[string]*Auctions, item int) []*ArbitrageOpportunity {
+	lowest := make(map[string]int64)
+	for realm, snapshot := range snapshots {
+		if snapshot == nil {
+			continue
+		}
+		for _, auction := range snapshot.Auctions {
+			price := auction.UnitPrice()
+			if auction.Item != item || price == 0 {
+				continue
+			}
+			if current, ok := lowest[realm]; !ok || price < current {
+				lowest[realm] = price
+			}
+		}
+	}
+
+	opportunities := make([]*ArbitrageOpportunity, 0)
+	for buyRealm, buyPrice := range lowest {
+		for sellRealm, sellPrice := range lowest {
+			if buyPrice < sellPrice {
+				opportunities = append(opportunities, &ArbitrageOpportunity{
+					Item:      item,
+					BuyRealm:  buyRealm,
+					BuyPrice:  buyPrice,
+					SellRealm: sellRealm,
+					SellPrice: sellPrice,
+				})
+			}
+		}
+	}
+	sort.Slice(opportunities, func(i, j int) bool {
+		a, b := opportunities[i], opportunities[j]
+		if a.Profit() != b.Profit() {
+			return a.Profit() > b.Profit()
+		}
+		if a.BuyRealm != b.BuyRealm {
+			return a.BuyRealm < b.BuyRealm
+		}
+		return a.SellRealm < b.SellRealm
+	})
+	return opportunities
+}
@@ -0,0 +1,40 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type AuctionArbitrageSuite struct{}
+
+var _ = Suite(&AuctionArbitrageSuite{})
+
+func (s *AuctionArbitrageSuite) Test_Arbitrage(c *C) {
+	snapshots := map[string]*Auctions{
+		"runetotem": &Auctions{Auctions: []*Auction{
+			&Auction{Item: 72092, Buyout: 2000, Quantity: 20},
+			&Auction{Item: 72092, Buyout: 0, Bid: 10, Quantity: 20},
+			&Auction{Item: 76133, Buyout: 10, Quantity: 1},
+		}},
+		"medivh": &Auctions{Auctions: []*Auction{
+			&Auction{Item: 72092, Buyout: 300, Quantity: 1},
+			&Auction{Item: 72092, Buyout: 250, Quantity: 1},
+		}},
+		"nazgrel": &Auctions{Auctions: []*Auction{
+			&Auction{Item: 72092, Buyout: 1200, Quantity: 10},
+		}},
+		"exodar": &Auctions{Auctions: []*Auction{
+			&Auction{Item: 76133, Buyout: 10, Quantity: 1},
+		}},
+	}
+
+	opportunities := Arbitrage(snapshots, 72092)
+	c.Assert(len(opportunities), Equals, 3)
+	c.Assert(*opportunities[0], DeepEquals, ArbitrageOpportunity{Item: 72092, BuyRealm: "runetotem", BuyPrice: 100, SellRealm: "medivh", SellPrice: 250})
+	c.Assert(opportunities[0].Profit(), Equals, int64(150))
+	c.Assert(opportunities[1].BuyRealm, Equals, "nazgrel")
+	c.Assert(opportunities[1].SellRealm, Equals, "medivh")
+	c.Assert(opportunities[2].Profit(), Equals, int64(20))
+
+	c.Assert(len(Arbitrage(snapshots, 76133)), Equals, 0)
+	c.Assert(len(Arbitrage(snapshots, 1)), Equals, 0)
+}
@@ -0,0 +1,62 @@
+package wow
+
+import (
+	"io"
+	"sort"
+)
+
+// GetCheapestAuctions downloads the realm's auction dump and keeps only
+// the perItem listings with the lowest unit price for each item,
+// discarding the rest as the dump is read. Auctions without a buyout
+// are skipped. The result is ordered by item id, then unit price.
+// Options apply as for GetAuctions.
+func (a *ApiClient) GetCheapestAuctions(realm string, perItem int, opts ...AuctionOption) (*Auctions, error) {
+	reader, closer, err := a.openAuctions(realm, newAuctionOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	cheapest := make(map[int][]*Auction)
+	for {
+		auction, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if auction.UnitPrice() == 0 || perItem < 1 {
+			continue
+		}
+		cheapest[auction.Item] = keepCheapest(cheapest[auction.Item], auction, perItem)
+	}
+
+	items := make([]int, 0, len(cheapest))
+	for item := range cheapest {
+		items = append(items, item)
+	}
+	sort.Ints(items)
+	auctions := &Auctions{Realms: reader.Realms, Auctions: make([]*Auction, 0)}
+	for _, item := range items {
+		auctions.Auctions = append(auctions.Auctions, cheapest[item]...)
+	}
+	return auctions, nil
+}
+
+// keepCheapest inserts auction into listings, which are sorted by unit
+// price, and drops the most expensive listing beyond limit.
+func keepCheapest(listings []*Auction, auction *Auction, limit int) []*Auction {
+	i := sort.Search(len(listings), func(i int) bool {
+		return listings[i].UnitPrice() > auction.UnitPrice()
+	})
+	if i >= limit {
+		return listings
+	}
+	if len(listings) < limit {
+		listings = append(listings, nil)
+	}
+	copy(listings[i+1:], listings[i:])
+	listings[i] = auction
+	return listings
+}
@@ -0,0 +1,34 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type AuctionCheapestSuite struct{}
+
+var _ = Suite(&AuctionCheapestSuite{})
+
+func (s *AuctionCheapestSuite) Test_GetCheapestAuctions(c *C) {
+	server := auctionServer(`{"realms": [{"name": "Runetotem", "slug": "runetotem"}], "auctions": [
+		{"auc": 1, "item": 72092, "buyout": 10000, "quantity": 20},
+		{"auc": 2, "item": 72092, "bid": 400, "buyout": 0, "quantity": 1},
+		{"auc": 3, "item": 76133, "buyout": 150, "quantity": 5},
+		{"auc": 4, "item": 72092, "buyout": 400, "quantity": 1},
+		{"auc": 5, "item": 72092, "buyout": 4000, "quantity": 10}
+	]}`)
+	defer server.Close()
+
+	a, err := testClient(server).GetCheapestAuctions("runetotem", 2)
+	c.Assert(err, IsNil)
+	c.Assert(a.Realms[0].Slug, Equals, "runetotem")
+	aucs := make([]int, 0)
+	for _, auction := range a.Auctions {
+		aucs = append(aucs, auction.Auc)
+	}
+	c.Assert(aucs, DeepEquals, []int{4, 5, 3})
+}
+
+func (s *AuctionCheapestSuite) Test_UnitPrice(c *C) {
+	c.Assert((&Auction{Buyout: 10000, Quantity: 20}).UnitPrice(), Equals, int64(500))
+	c.Assert((&Auction{Bid: 100, Quantity: 1}).UnitPrice(), Equals, int64(0))
+}
@@ -1,5 +1,66 @@
 package wow
 
+import (
+	"errors"
+	"time"
+)
+
+// AuctionData describes a realm's auction dump. Each of Files has the
+// Url of a dump to download separately, e.g. with GetAuctions or
+// StreamAuctions, and when it was last modified.
 type AuctionData struct {
 	Files []*AuctionDataFiles
 }
+
+// ErrNotModified is returned by GetAuctionDataIfModified when the
+// auction data hasn't changed.
+var ErrNotModified = errors.New("Auction data has not been modified")
+
+// GetAuctionDataIfModified fetches the realm's auction data, returning
+// ErrNotModified if no file has been modified after since, in
+// milliseconds since the epoch. Pollers can pass the previous result's
+// LastModifiedMillis to only download new dumps.
+func (a *ApiClient) GetAuctionDataIfModified(realm string, since int64) (*AuctionData, error) {
+	data, err := a.GetAuctionData(realm)
+	if err != nil {
+		return nil, err
+	}
+	if data.LastModifiedMillis() <= since {
+		return nil, ErrNotModified
+	}
+	return data, nil
+}
+
+// LastModifiedMillis returns the most recent modification time of the
+// auction files in milliseconds since the epoch, as the API reports
+// it, or 0 if there are none.
+func (a *AuctionData) LastModifiedMillis() int64 {
+	var latest int64
+	for _, f := range a.Files {
+		if int64(f.LastModified) > latest {
+			latest = int64(f.LastModified)
+		}
+	}
+	return latest
+}
+
+// LastModified returns the most recent modification time of the
+// auction files, or the zero time if there are none.
+func (a *AuctionData) LastModified() time.Time {
+	var latest time.Time
+	for _, f := range a.Files {
+		if t := f.LastModifiedTime(); t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+// IsStale reports whether the auction files haven't been modified for
+// longer than threshold. Auction data without files is always stale.
+func (a *AuctionData) IsStale(threshold time.Duration) bool {
+	if len(a.Files) == 0 {
+		return true
+	}
+	return time.Since(a.LastModified()) > threshold
+}
@@ -1,6 +1,20 @@
 package wow
 
+import (
+	"time"
+)
+
 type AuctionDataFiles struct {
 	LastModified uint
 	Url          string
 }
+
+// LastModifiedTime converts LastModified, which the API reports in
+// milliseconds since the epoch, to a time.Time.
+func (f *AuctionDataFiles) LastModifiedTime() time.Time {
+	return millisToTime(f.LastModified)
+}
+
+func millisToTime(millis uint) time.Time {
+	return time.Unix(int64(millis/1000), int64(millis%1000)*int64(time.Millisecond))
+}
@@ -0,0 +1,50 @@
+package wow
+
+import (
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+type AuctionDataSuite struct{}
+
+var _ = Suite(&AuctionDataSuite{})
+
+func (s *AuctionDataSuite) Test_IsStale(c *C) {
+	now := uint(time.Now().UnixNano() / int64(time.Millisecond))
+	hour := uint(time.Hour / time.Millisecond)
+	a := &AuctionData{Files: []*AuctionDataFiles{
+		&AuctionDataFiles{LastModified: now - 3*hour},
+		&AuctionDataFiles{LastModified: now - hour},
+	}}
+	c.Assert(a.LastModified(), Equals, millisToTime(now-hour))
+	c.Assert(a.IsStale(2*time.Hour), Equals, false)
+	c.Assert(a.IsStale(30*time.Minute), Equals, true)
+	c.Assert((&AuctionData{}).IsStale(time.Hour), Equals, true)
+}
+
+func (s *AuctionDataSuite) Test_GetAuctionDataIfModified(c *C) {
+	lastModified := 1400000000000
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"files": [{"url": "http://auction-api-us.worldofwarcraft.com/auction-data/runetotem/auctions.json", "lastModified": %d}]}`, lastModified)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	data, err := client.GetAuctionDataIfModified("runetotem", 0)
+	c.Assert(err, IsNil)
+	since := data.LastModifiedMillis()
+	c.Assert(since, Equals, int64(1400000000000))
+
+	data, err = client.GetAuctionDataIfModified("runetotem", since)
+	c.Assert(err, Equals, ErrNotModified)
+	c.Assert(data, IsNil)
+
+	lastModified += 3600000
+	data, err = client.GetAuctionDataIfModified("runetotem", since)
+	c.Assert(err, IsNil)
+	c.Assert(data.LastModifiedMillis(), Equals, int64(1400003600000))
+	c.Assert((&AuctionData{}).LastModifiedMillis(), Equals, int64(0))
+}
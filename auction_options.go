@@ -0,0 +1,87 @@
+package wow
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// AuctionOption configures one call of GetAuctions, StreamAuctions or
+// DownloadAuctions.
+type AuctionOption func(o *auctionOptions)
+
+type auctionOptions struct {
+	metadataTimeout time.Duration
+	downloadTimeout time.Duration
+}
+
+// WithMetadataTimeout bounds the request for the realm's auction data,
+// which is small and should be quick. By default it's only bounded by
+// the client's context and HttpClient, whose timeout is DefaultTimeout
+// for clients made by NewApiClient.
+func WithMetadataTimeout(timeout time.Duration) AuctionOption {
+	return func(o *auctionOptions) {
+		o.metadataTimeout = timeout
+	}
+}
+
+// DefaultDownloadTimeout bounds auction file downloads unless
+// WithDownloadTimeout says otherwise.
+const DefaultDownloadTimeout = 10 * time.Minute
+
+// WithDownloadTimeout bounds the download of the auction file, from
+// the request until the file has been read. Files can be tens of
+// megabytes, so downloads aren't held to the timeout of the
+// *http.Client they're made with, which is meant for API requests;
+// they're bounded by timeout instead, or by default by
+// DefaultDownloadTimeout. A timeout of 0 holds the download to the
+// *http.Client's timeout after all.
+func WithDownloadTimeout(timeout time.Duration) AuctionOption {
+	return func(o *auctionOptions) {
+		o.downloadTimeout = timeout
+	}
+}
+
+func newAuctionOptions(opts []AuctionOption) auctionOptions {
+	options := auctionOptions{downloadTimeout: DefaultDownloadTimeout}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// withTimeout returns a copy of the client whose context times out
+// after timeout, and the context's cancel function. A timeout of 0
+// returns the client itself and a no-op.
+func (a *ApiClient) withTimeout(timeout time.Duration) (*ApiClient, context.CancelFunc) {
+	if timeout <= 0 {
+		return a, func() {}
+	}
+	ctx, cancel := context.WithTimeout(a.Context(), timeout)
+	return a.WithContext(ctx), cancel
+}
+
+// withoutClientTimeout returns doer with its timeout lifted if it's an
+// *http.Client, so a context deadline can be longer than the timeout.
+func withoutClientTimeout(doer Doer) Doer {
+	if httpClient, ok := doer.(*http.Client); ok && httpClient.Timeout != 0 {
+		client := *httpClient
+		client.Timeout = 0
+		return &client
+	}
+	return doer
+}
+
+// cancelOnClose cancels a context once the body read under it is
+// closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
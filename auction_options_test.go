@@ -0,0 +1,51 @@
+package wow
+
+import (
+	"context"
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+type AuctionOptionsSuite struct{}
+
+var _ = Suite(&AuctionOptionsSuite{})
+
+func (s *AuctionOptionsSuite) Test_phaseTimeouts(c *C) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auctions.json":
+			// A slow download: half the dump, a pause, then the rest.
+			w.Write([]byte(auctionDumpJson[:100]))
+			w.(http.Flusher).Flush()
+			time.Sleep(150 * time.Millisecond)
+			w.Write([]byte(auctionDumpJson[100:]))
+		case "/wow/auction/data/slow":
+			time.Sleep(50 * time.Millisecond)
+			fallthrough
+		default:
+			fmt.Fprintf(w, `{"files": [{"url": "%s/auctions.json", "lastModified": 1400000000000}]}`, server.URL)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.HttpClient = &http.Client{Timeout: 100 * time.Millisecond}
+
+	_, err := client.GetAuctions("slow", WithMetadataTimeout(10*time.Millisecond))
+	c.Assert(err, Equals, context.DeadlineExceeded)
+
+	// The download isn't held to the client's timeout.
+	a, err := client.GetAuctions("runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(len(a.Auctions), Equals, 3)
+
+	a, err = client.GetAuctions("runetotem", WithMetadataTimeout(50*time.Millisecond), WithDownloadTimeout(time.Second))
+	c.Assert(err, IsNil)
+	c.Assert(len(a.Auctions), Equals, 3)
+
+	_, err = client.GetAuctions("runetotem", WithDownloadTimeout(50*time.Millisecond))
+	c.Assert(err, Equals, context.DeadlineExceeded)
+}
@@ -0,0 +1,116 @@
+package wow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// AuctionReader decodes an auction house dump one auction at a time, so
+// a dump doesn't have to be held in memory all at once.
+type AuctionReader struct {
+	decoder *json.Decoder
+	// Realms is filled in once the reader has passed the dump's realms.
+	// Dumps list them before the auctions.
+	Realms     []*Realm
+	inAuctions bool
+	done       bool
+}
+
+func NewAuctionReader(r io.Reader) *AuctionReader {
+	return &AuctionReader{decoder: json.NewDecoder(r)}
+}
+
+// Next returns the next auction, or io.EOF once the dump is exhausted.
+func (r *AuctionReader) Next() (*Auction, error) {
+	if r.done {
+		return nil, io.EOF
+	}
+	if !r.inAuctions {
+		err := r.seekAuctions()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.decoder.More() {
+		auction := &Auction{}
+		err := r.decoder.Decode(auction)
+		if err != nil {
+			return nil, err
+		}
+		return auction, nil
+	}
+
+	// Closing ']' of the auctions.
+	_, err := r.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	r.inAuctions = false
+	return r.Next()
+}
+
+// seekAuctions advances to the first auction, reading the realms along
+// the way. It marks the reader done at the end of the dump.
+func (r *AuctionReader) seekAuctions() error {
+	if r.decoder.InputOffset() == 0 {
+		err := r.expectDelim('{')
+		if err != nil {
+			return err
+		}
+	}
+	for r.decoder.More() {
+		token, err := r.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case "auctions":
+			err = r.expectDelim('[')
+			if err != nil {
+				return err
+			}
+			r.inAuctions = true
+			return nil
+		case "realms":
+			err = r.decoder.Decode(&r.Realms)
+		default:
+			var skip json.RawMessage
+			err = r.decoder.Decode(&skip)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	r.done = true
+	return io.EOF
+}
+
+func (r *AuctionReader) expectDelim(delim json.Delim) error {
+	token, err := r.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return errors.New(fmt.Sprintf("Auction dump is malformed: expected '%s' but found '%v'", delim, token))
+	}
+	return nil
+}
+
+// ReadAll reads the remaining auctions.
+func (r *AuctionReader) ReadAll() (*Auctions, error) {
+	auctions := &Auctions{Auctions: make([]*Auction, 0)}
+	for {
+		auction, err := r.Next()
+		if err == io.EOF {
+			auctions.Realms = r.Realms
+			return auctions, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		auctions.Auctions = append(auctions.Auctions, auction)
+	}
+}
@@ -0,0 +1,94 @@
+package wow
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// AuctionSchedule estimates when a realm's auction files are updated,
+// based on previously observed lastModified values. Interval is the
+// typical time between updates and Drift is how far individual updates
+// tend to stray from it.
+type AuctionSchedule struct {
+	Interval   time.Duration
+	Drift      time.Duration
+	LastUpdate time.Time
+}
+
+// NewAuctionSchedule builds an AuctionSchedule from a history of
+// AuctionDataFiles.LastModified values (milliseconds since the
+// epoch). The history does not need to be sorted and may contain
+// repeats, but at least two distinct values are required.
+func NewAuctionSchedule(lastModified []uint) (*AuctionSchedule, error) {
+	stamps := make([]uint, 0, len(lastModified))
+	stamps = append(stamps, lastModified...)
+	sort.Slice(stamps, func(i, j int) bool { return stamps[i] < stamps[j] })
+
+	deltas := make([]time.Duration, 0, len(stamps))
+	for i := 1; i < len(stamps); i++ {
+		if stamps[i] != stamps[i-1] {
+			deltas = append(deltas, time.Duration(stamps[i]-stamps[i-1])*time.Millisecond)
+		}
+	}
+	if len(deltas) == 0 {
+		return nil, errors.New("At least two distinct lastModified values are needed to estimate an auction schedule")
+	}
+
+	sort.Slice(deltas, func(i, j int) bool { return deltas[i] < deltas[j] })
+	interval := deltas[len(deltas)/2]
+	if len(deltas)%2 == 0 {
+		interval = (deltas[len(deltas)/2-1] + deltas[len(deltas)/2]) / 2
+	}
+
+	var deviation time.Duration
+	for _, d := range deltas {
+		if d > interval {
+			deviation += d - interval
+		} else {
+			deviation += interval - d
+		}
+	}
+
+	return &AuctionSchedule{
+		Interval:   interval,
+		Drift:      deviation / time.Duration(len(deltas)),
+		LastUpdate: millisToTime(stamps[len(stamps)-1]),
+	}, nil
+}
+
+// NewAuctionSchedules builds an AuctionSchedule for each realm in
+// history. Realms without enough observations are left out.
+func NewAuctionSchedules(history map[string][]uint) map[string]*AuctionSchedule {
+	schedules := make(map[string]*AuctionSchedule)
+	for realm, lastModified := range history {
+		schedule, err := NewAuctionSchedule(lastModified)
+		if err == nil {
+			schedules[realm] = schedule
+		}
+	}
+	return schedules
+}
+
+// NextUpdate returns the time the update following LastUpdate is
+// expected.
+func (s *AuctionSchedule) NextUpdate() time.Time {
+	return s.LastUpdate.Add(s.Interval)
+}
+
+// NextUpdateAfter returns the first expected update after t. Missed
+// updates are skipped by stepping forward a whole Interval at a time.
+func (s *AuctionSchedule) NextUpdateAfter(t time.Time) time.Time {
+	next := s.NextUpdate()
+	if !next.After(t) {
+		missed := t.Sub(next)/s.Interval + 1
+		next = next.Add(missed * s.Interval)
+	}
+	return next
+}
+
+// PollAt returns when a scheduler should start polling for the update
+// following t, allowing for the schedule's drift.
+func (s *AuctionSchedule) PollAt(t time.Time) time.Time {
+	return s.NextUpdateAfter(t).Add(-s.Drift)
+}
@@ -0,0 +1,34 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"time"
+)
+
+type AuctionScheduleSuite struct{}
+
+var _ = Suite(&AuctionScheduleSuite{})
+
+func (s *AuctionScheduleSuite) Test_NewAuctionSchedule(c *C) {
+	minute := uint(60 * 1000)
+	start := uint(1400000000000)
+	a, err := NewAuctionSchedule([]uint{start + 122*minute, start, start + 58*minute, start + 58*minute})
+	c.Assert(err, IsNil)
+	c.Assert(a.Interval, Equals, 61*time.Minute)
+	c.Assert(a.Drift, Equals, 3*time.Minute)
+	c.Assert(a.LastUpdate, Equals, millisToTime(start+122*minute))
+	c.Assert(a.NextUpdate(), Equals, millisToTime(start+183*minute))
+}
+
+func (s *AuctionScheduleSuite) Test_NewAuctionSchedule_notEnoughData(c *C) {
+	_, err := NewAuctionSchedule([]uint{1400000000000, 1400000000000})
+	c.Assert(err, NotNil)
+}
+
+func (s *AuctionScheduleSuite) Test_NextUpdateAfter(c *C) {
+	last := time.Unix(1400000000, 0)
+	a := &AuctionSchedule{Interval: time.Hour, Drift: time.Minute, LastUpdate: last}
+	c.Assert(a.NextUpdateAfter(last.Add(30*time.Minute)), Equals, last.Add(time.Hour))
+	c.Assert(a.NextUpdateAfter(last.Add(150*time.Minute)), Equals, last.Add(3*time.Hour))
+	c.Assert(a.PollAt(last), Equals, last.Add(59*time.Minute))
+}
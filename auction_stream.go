@@ -0,0 +1,266 @@
+package wow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// GetAuctions fetches the realm's auction data and downloads the
+// auction dump it points to. Options such as WithDownloadTimeout set
+// separate timeouts for the two.
+func (a *ApiClient) GetAuctions(realm string, opts ...AuctionOption) (*Auctions, error) {
+	reader, closer, err := a.openAuctions(realm, newAuctionOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return reader.ReadAll()
+}
+
+// StreamAuctions downloads the realm's auction dump and sends each
+// auction on the returned channel as it's decoded, so consumers can
+// process a dump with bounded memory.
+//
+// The auction channel is closed once the dump has been read, an error
+// occurs or ctx is done. The error channel then receives the error, if
+// any (ctx.Err() when ctx is done), and is closed. Consumers should
+// drain the auction channel before reading the error channel, or
+// cancel ctx if they stop early. Options apply as for GetAuctions.
+func (a *ApiClient) StreamAuctions(ctx context.Context, realm string, opts ...AuctionOption) (<-chan *Auction, <-chan error) {
+	options := newAuctionOptions(opts)
+	auctions := make(chan *Auction)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		err := a.WithContext(ctx).streamAuctions(ctx, realm, options, auctions)
+		close(auctions)
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return auctions, errs
+}
+
+func (a *ApiClient) streamAuctions(ctx context.Context, realm string, options auctionOptions, auctions chan<- *Auction) error {
+	reader, closer, err := a.openAuctions(realm, options)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		auction, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		select {
+		case auctions <- auction:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// DownloadAuctions downloads the first auction dump of data, as
+// returned by GetAuctionData. The dump is decoded as it streams in
+// rather than read into memory first. WithDownloadTimeout applies.
+func (a *ApiClient) DownloadAuctions(data *AuctionData, opts ...AuctionOption) ([]*Auction, error) {
+	if len(data.Files) == 0 {
+		return nil, errors.New("No auction files available")
+	}
+	reader, closer, err := a.openAuctionFile(data, newAuctionOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	auctions, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return auctions.Auctions, nil
+}
+
+// openAuctions fetches the realm's auction data and starts downloading
+// the first auction file. The caller must close the returned closer.
+func (a *ApiClient) openAuctions(realm string, options auctionOptions) (*AuctionReader, io.Closer, error) {
+	client, cancel := a.withTimeout(options.metadataTimeout)
+	data, err := client.GetAuctionData(realm)
+	cancel()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data.Files) == 0 {
+		return nil, nil, errors.New(fmt.Sprintf("No auction files available for '%s'", realm))
+	}
+	return a.openAuctionFile(data, options)
+}
+
+// openAuctionFile starts downloading the first auction file of data,
+// which must have one.
+func (a *ApiClient) openAuctionFile(data *AuctionData, options auctionOptions) (*AuctionReader, io.Closer, error) {
+	if a.MaxAuctionBytes > 0 {
+		err := a.checkAuctionFileSize(data.Files[0].Url)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	body, err := a.downloadAuctionFile(data.Files[0].Url, options.downloadTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewAuctionReader(body), body, nil
+}
+
+// downloadAuctionFile starts downloading the auction file at fileUrl.
+// timeout bounds the whole download instead of the Doer's timeout. The
+// caller must close the returned body.
+func (a *ApiClient) downloadAuctionFile(fileUrl string, timeout time.Duration) (io.ReadCloser, error) {
+	client, cancel := a.withTimeout(timeout)
+	body, err := client.openAuctionFileBody(fileUrl, timeout > 0)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelOnClose{ReadCloser: body, cancel: cancel}, nil
+}
+
+func (a *ApiClient) openAuctionFileBody(fileUrl string, liftTimeout bool) (io.ReadCloser, error) {
+	request, err := a.auctionFileRequest("GET", fileUrl)
+	if err != nil {
+		return nil, err
+	}
+	doer := a.doerFor(AuctionFilesEndpoint)
+	if liftTimeout {
+		doer = withoutClientTimeout(doer)
+	}
+	response, err := doer.Do(request)
+	if err != nil {
+		return nil, a.contextError(err)
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, errors.New(fmt.Sprintf("Downloading auction file '%s' failed: %s", fileUrl, response.Status))
+	}
+	if a.MaxAuctionBytes > 0 && response.ContentLength > a.MaxAuctionBytes {
+		response.Body.Close()
+		return nil, ErrAuctionTooLarge
+	}
+	var body io.ReadCloser = response.Body
+	if response.ContentLength >= 0 {
+		body = &lengthCheckingBody{ReadCloser: body, url: fileUrl, expected: response.ContentLength}
+	}
+	decompressed, err := decompressedBody(response, body)
+	if err != nil {
+		body.Close()
+		return nil, a.contextError(err)
+	}
+	// The limit applies to the decompressed file, since a small gzipped
+	// file can expand without bound.
+	if a.MaxAuctionBytes > 0 {
+		decompressed = &limitedBody{ReadCloser: decompressed, remaining: a.MaxAuctionBytes}
+	}
+	return decompressed, nil
+}
+
+// ErrAuctionTooLarge is returned when an auction file is larger than
+// the client's MaxAuctionBytes.
+var ErrAuctionTooLarge = errors.New("Auction file exceeds MaxAuctionBytes")
+
+// checkAuctionFileSize sends a HEAD request for the auction file at
+// fileUrl and returns ErrAuctionTooLarge if its Content-Length exceeds
+// MaxAuctionBytes, so oversized files are rejected before downloading.
+// Servers that don't report a length are left to the download.
+func (a *ApiClient) checkAuctionFileSize(fileUrl string) error {
+	request, err := a.auctionFileRequest("HEAD", fileUrl)
+	if err != nil {
+		return err
+	}
+	response, err := a.doerFor(AuctionFilesEndpoint).Do(request)
+	if err != nil {
+		return a.contextError(err)
+	}
+	response.Body.Close()
+	if response.StatusCode == http.StatusOK && response.ContentLength > a.MaxAuctionBytes {
+		return ErrAuctionTooLarge
+	}
+	return nil
+}
+
+// limitedBody fails with ErrAuctionTooLarge once more than remaining
+// bytes have been read, for downloads without a Content-Length or that
+// are compressed.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		// Drop the data, so the decoder can't finish without seeing
+		// the error.
+		return 0, ErrAuctionTooLarge
+	}
+	return n, err
+}
+
+// auctionFileRequest builds a request for the auction file at fileUrl,
+// sent to AuctionFilesHost if set.
+func (a *ApiClient) auctionFileRequest(method string, fileUrl string) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(a.Context(), method, fileUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	if a.AuctionFilesHost != "" {
+		request.URL.Host = a.AuctionFilesHost
+		request.Host = a.AuctionFilesHost
+	}
+	return request, nil
+}
+
+// TruncatedDownloadError is returned when an auction file download
+// ends before its Content-Length was received, typically because the
+// connection dropped. Retrying the download usually succeeds.
+type TruncatedDownloadError struct {
+	Url      string
+	Expected int64
+	Received int64
+}
+
+func (e *TruncatedDownloadError) Error() string {
+	return fmt.Sprintf("Download of '%s' was truncated after %d of %d bytes", e.Url, e.Received, e.Expected)
+}
+
+// lengthCheckingBody turns a body that ends before its Content-Length
+// into a *TruncatedDownloadError, rather than leaving the JSON decoder
+// to fail on the partial document.
+type lengthCheckingBody struct {
+	io.ReadCloser
+	url      string
+	expected int64
+	received int64
+}
+
+func (b *lengthCheckingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.received += int64(n)
+	if (err == io.EOF || err == io.ErrUnexpectedEOF) && b.received < b.expected {
+		err = &TruncatedDownloadError{Url: b.url, Expected: b.expected, Received: b.received}
+	}
+	return n, err
+}
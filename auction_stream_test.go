@@ -0,0 +1,201 @@
+package wow
+
+import (
+	"context"
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type AuctionStreamSuite struct{}
+
+var _ = Suite(&AuctionStreamSuite{})
+
+const auctionDumpJson = `{
+	"realms": [{"name": "Runetotem", "slug": "runetotem"}, {"name": "Nazgrel", "slug": "nazgrel"}],
+	"auctions": [
+		{"auc": 1, "item": 72092, "owner": "Capoferro", "ownerRealm": "Runetotem", "bid": 9000, "buyout": 10000, "quantity": 20, "timeLeft": "LONG", "rand": 0, "seed": 0},
+		{"auc": 2, "item": 72092, "owner": "Someone", "ownerRealm": "Nazgrel", "bid": 400, "buyout": 0, "quantity": 1, "timeLeft": "SHORT", "rand": 0, "seed": 0},
+		{"auc": 3, "item": 76133, "owner": "Capoferro", "ownerRealm": "Runetotem", "bid": 100, "buyout": 150, "quantity": 5, "timeLeft": "VERY_LONG", "rand": 0, "seed": 0}
+	]
+}`
+
+// auctionServer serves auction data for any realm, pointing at a dump
+// with the given body.
+func auctionServer(dump string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			w.Write([]byte(dump))
+			return
+		}
+		fmt.Fprintf(w, `{"files": [{"url": "%s/auctions.json", "lastModified": 1400000000000}]}`, server.URL)
+	}))
+	return server
+}
+
+func (s *AuctionStreamSuite) Test_AuctionReader(c *C) {
+	r := NewAuctionReader(strings.NewReader(auctionDumpJson))
+	a, err := r.ReadAll()
+	c.Assert(err, IsNil)
+	c.Assert(len(a.Realms), Equals, 2)
+	c.Assert(len(a.Auctions), Equals, 3)
+	c.Assert(a.Auctions[0].Owner, Equals, "Capoferro")
+	c.Assert(a.Auctions[0].Buyout, Equals, int64(10000))
+	c.Assert(a.Auctions[2].TimeLeft, Equals, "VERY_LONG")
+}
+
+func (s *AuctionStreamSuite) Test_AuctionReader_auctionsFirst(c *C) {
+	r := NewAuctionReader(strings.NewReader(`{"auctions": [{"auc": 1}], "realms": [{"slug": "runetotem"}]}`))
+	a, err := r.ReadAll()
+	c.Assert(err, IsNil)
+	c.Assert(len(a.Auctions), Equals, 1)
+	c.Assert(a.Realms[0].Slug, Equals, "runetotem")
+}
+
+func (s *AuctionStreamSuite) Test_AuctionReader_malformed(c *C) {
+	_, err := NewAuctionReader(strings.NewReader(`[]`)).ReadAll()
+	c.Assert(err, NotNil)
+}
+
+func (s *AuctionStreamSuite) Test_GetAuctions(c *C) {
+	server := auctionServer(auctionDumpJson)
+	defer server.Close()
+
+	a, err := testClient(server).GetAuctions("runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(len(a.Auctions), Equals, 3)
+}
+
+func (s *AuctionStreamSuite) Test_DownloadAuctions(c *C) {
+	server := auctionServer(auctionDumpJson)
+	defer server.Close()
+	client := testClient(server)
+
+	data, err := client.GetAuctionData("runetotem")
+	c.Assert(err, IsNil)
+	auctions, err := client.DownloadAuctions(data)
+	c.Assert(err, IsNil)
+	c.Assert(len(auctions), Equals, 3)
+	c.Assert(*auctions[1], DeepEquals, Auction{Auc: 2, Item: 72092, Owner: "Someone", OwnerRealm: "Nazgrel", Bid: 400, Quantity: 1, TimeLeft: "SHORT"})
+
+	_, err = client.DownloadAuctions(&AuctionData{})
+	c.Assert(err, NotNil)
+}
+
+func (s *AuctionStreamSuite) Test_StreamAuctions(c *C) {
+	server := auctionServer(auctionDumpJson)
+	defer server.Close()
+
+	auctions, errs := testClient(server).StreamAuctions(context.Background(), "runetotem")
+	ids := make([]int, 0)
+	for auction := range auctions {
+		ids = append(ids, auction.Auc)
+	}
+	c.Assert(<-errs, IsNil)
+	c.Assert(ids, DeepEquals, []int{1, 2, 3})
+}
+
+func (s *AuctionStreamSuite) Test_StreamAuctions_cancelled(c *C) {
+	server := auctionServer(auctionDumpJson)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	auctions, errs := testClient(server).StreamAuctions(ctx, "runetotem")
+	<-auctions
+	cancel()
+	for range auctions {
+	}
+	c.Assert(<-errs, Equals, context.Canceled)
+}
+
+func (s *AuctionStreamSuite) Test_GetAuctions_truncated(c *C) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			w.Header().Set("Content-Length", fmt.Sprint(len(auctionDumpJson)))
+			w.Write([]byte(auctionDumpJson[:200]))
+			return
+		}
+		fmt.Fprintf(w, `{"files": [{"url": "%s/auctions.json", "lastModified": 1400000000000}]}`, server.URL)
+	}))
+	defer server.Close()
+
+	_, err := testClient(server).GetAuctions("runetotem")
+	truncated, ok := err.(*TruncatedDownloadError)
+	c.Assert(ok, Equals, true)
+	c.Assert(truncated.Received, Equals, int64(200))
+	c.Assert(truncated.Expected, Equals, int64(len(auctionDumpJson)))
+}
+
+func (s *AuctionStreamSuite) Test_GetAuctions_auctionFilesHost(c *C) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/auction-data/runetotem/auctions.json")
+		w.Write([]byte(auctionDumpJson))
+	}))
+	defer proxy.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"files": [{"url": "http://auction-api-us.worldofwarcraft.com/auction-data/runetotem/auctions.json", "lastModified": 1400000000000}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.AuctionFilesHost = strings.TrimPrefix(proxy.URL, "http://")
+
+	a, err := client.GetAuctions("runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(len(a.Auctions), Equals, 3)
+}
+
+func (s *AuctionStreamSuite) Test_GetAuctions_tooLarge(c *C) {
+	downloads := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			w.Header().Set("Content-Length", fmt.Sprint(len(auctionDumpJson)))
+			if r.Method == "GET" {
+				downloads++
+				w.Write([]byte(auctionDumpJson))
+			}
+			return
+		}
+		fmt.Fprintf(w, `{"files": [{"url": "%s/auctions.json", "lastModified": 1400000000000}]}`, server.URL)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	client.MaxAuctionBytes = 100
+	_, err := client.GetAuctions("runetotem")
+	c.Assert(err, Equals, ErrAuctionTooLarge)
+	c.Assert(downloads, Equals, 0)
+
+	client.MaxAuctionBytes = int64(len(auctionDumpJson))
+	a, err := client.GetAuctions("runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(len(a.Auctions), Equals, 3)
+	c.Assert(downloads, Equals, 1)
+}
+
+func (s *AuctionStreamSuite) Test_GetAuctions_tooLargeWithoutLength(c *C) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			// Flushing first makes the response chunked, without a length.
+			w.(http.Flusher).Flush()
+			w.Write([]byte(auctionDumpJson))
+			return
+		}
+		fmt.Fprintf(w, `{"files": [{"url": "%s/auctions.json", "lastModified": 1400000000000}]}`, server.URL)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	client.MaxAuctionBytes = 100
+	_, err := client.GetAuctions("runetotem")
+	c.Assert(err, Equals, ErrAuctionTooLarge)
+
+	client.MaxAuctionBytes = int64(len(auctionDumpJson))
+	_, err = client.GetAuctions("runetotem")
+	c.Assert(err, IsNil)
+}
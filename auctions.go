@@ -0,0 +1,104 @@
+package wow
+
+// Auctions is the content of an auction house dump: the realms sharing
+// the auction house and their auctions.
+type Auctions struct {
+	Realms   []*Realm
+	Auctions []*Auction
+}
+
+// GroupByItem groups the auctions by item id.
+func GroupByItem(a *Auctions) map[int][]*Auction {
+	counts := make(map[int]int)
+	for _, auction := range a.Auctions {
+		counts[auction.Item]++
+	}
+	groups := make(map[int][]*Auction, len(counts))
+	for _, auction := range a.Auctions {
+		group, ok := groups[auction.Item]
+		if !ok {
+			group = make([]*Auction, 0, counts[auction.Item])
+		}
+		groups[auction.Item] = append(group, auction)
+	}
+	return groups
+}
+
+// GroupByOwner groups the auctions by seller. Sellers are keyed as
+// "Name-Realm", since connected realms can have characters of the same
+// name.
+func GroupByOwner(a *Auctions) map[string][]*Auction {
+	counts := make(map[string]int)
+	for _, auction := range a.Auctions {
+		counts[auction.Seller()]++
+	}
+	groups := make(map[string][]*Auction, len(counts))
+	for _, auction := range a.Auctions {
+		seller := auction.Seller()
+		group, ok := groups[seller]
+		if !ok {
+			group = make([]*Auction, 0, counts[seller])
+		}
+		groups[seller] = append(group, auction)
+	}
+	return groups
+}
+
+// AuctionVolumeStats summarizes the size of an auction house snapshot.
+type AuctionVolumeStats struct {
+	Listings      int
+	UniqueItems   int
+	UniqueSellers int
+	// TotalQuantity is the number of items listed, counting each item
+	// of a stack.
+	TotalQuantity int64
+}
+
+// AuctionVolume summarizes the snapshot, for building time series of
+// auction house activity.
+func AuctionVolume(a *Auctions) AuctionVolumeStats {
+	type seller struct {
+		owner string
+		realm string
+	}
+	items := make(map[int]bool)
+	sellers := make(map[seller]bool)
+	stats := AuctionVolumeStats{Listings: len(a.Auctions)}
+	for _, auction := range a.Auctions {
+		items[auction.Item] = true
+		sellers[seller{auction.Owner, auction.OwnerRealm}] = true
+		stats.TotalQuantity += int64(auction.Quantity)
+	}
+	stats.UniqueItems = len(items)
+	stats.UniqueSellers = len(sellers)
+	return stats
+}
+
+// DedupeAuctions merges snapshots into one, dropping auctions whose
+// Auc id was already seen, so auctions fetched twice through
+// overlapping connected realms are only counted once. The first
+// occurrence of an id is kept. Realms are merged the same way, by
+// slug.
+func DedupeAuctions(snapshots ...*Auctions) *Auctions {
+	merged := &Auctions{Realms: make([]*Realm, 0), Auctions: make([]*Auction, 0)}
+	seenRealms := make(map[string]bool)
+	seenAuctions := make(map[int]bool)
+	for _, snapshot := range snapshots {
+		if snapshot == nil {
+			continue
+		}
+		for _, realm := range snapshot.Realms {
+			if !seenRealms[realm.Slug] {
+				seenRealms[realm.Slug] = true
+				merged.Realms = append(merged.Realms, realm)
+			}
+		}
+		for _, auction := range snapshot.Auctions {
+			if !seenAuctions[auction.Auc] {
+				seenAuctions[auction.Auc] = true
+				merged.Auctions = append(merged.Auctions, auction)
+			}
+		}
+	}
+	return merged
+}
@@ -0,0 +1,58 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"strings"
+)
+
+type AuctionsSuite struct{}
+
+var _ = Suite(&AuctionsSuite{})
+
+func readAuctions(c *C, dump string) *Auctions {
+	a, err := NewAuctionReader(strings.NewReader(dump)).ReadAll()
+	c.Assert(err, IsNil)
+	return a
+}
+
+func (s *AuctionsSuite) Test_GroupByItem(c *C) {
+	groups := GroupByItem(readAuctions(c, auctionDumpJson))
+	c.Assert(len(groups), Equals, 2)
+	c.Assert(len(groups[72092]), Equals, 2)
+	c.Assert(groups[72092][1].Auc, Equals, 2)
+	c.Assert(len(groups[76133]), Equals, 1)
+}
+
+func (s *AuctionsSuite) Test_GroupByOwner(c *C) {
+	groups := GroupByOwner(readAuctions(c, auctionDumpJson))
+	c.Assert(len(groups), Equals, 2)
+	c.Assert(len(groups["Capoferro-Runetotem"]), Equals, 2)
+	c.Assert(len(groups["Someone-Nazgrel"]), Equals, 1)
+}
+
+func (s *AuctionsSuite) Test_AuctionVolume(c *C) {
+	stats := AuctionVolume(readAuctions(c, auctionDumpJson))
+	c.Assert(stats, Equals, AuctionVolumeStats{Listings: 3, UniqueItems: 2, UniqueSellers: 2, TotalQuantity: 26})
+	c.Assert(AuctionVolume(&Auctions{}), Equals, AuctionVolumeStats{})
+
+	// Sellers are told apart by name and realm, not their joined form.
+	stats = AuctionVolume(&Auctions{Auctions: []*Auction{
+		&Auction{Owner: "Tom-Bob", Quantity: 1},
+		&Auction{Owner: "Tom", OwnerRealm: "Bob", Quantity: 1},
+	}})
+	c.Assert(stats.UniqueSellers, Equals, 2)
+}
+
+func (s *AuctionsSuite) Test_DedupeAuctions(c *C) {
+	first := readAuctions(c, auctionDumpJson)
+	second := &Auctions{
+		Realms:   []*Realm{&Realm{Slug: "nazgrel"}, &Realm{Slug: "nesingwary"}},
+		Auctions: []*Auction{&Auction{Auc: 2, Owner: "Duplicate"}, &Auction{Auc: 4, Owner: "New"}},
+	}
+	merged := DedupeAuctions(first, nil, second)
+	c.Assert(len(merged.Realms), Equals, 3)
+	c.Assert(len(merged.Auctions), Equals, 4)
+	c.Assert(merged.Auctions[1].Owner, Equals, "Someone")
+	c.Assert(merged.Auctions[3].Owner, Equals, "New")
+	c.Assert(len(DedupeAuctions().Auctions), Equals, 0)
+}
@@ -0,0 +1,94 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Number of requests batch helpers run at once unless told otherwise.
+const DefaultBatchConcurrency = 4
+
+// forEach calls fn for every i in [0, n), running up to concurrency
+// calls at once. It returns the error of each call by index. Once the
+// client's context is done no more calls are started, and the calls
+// that were skipped get the context's error.
+func (a *ApiClient) forEach(n int, concurrency int, fn func(i int) error) []error {
+	if concurrency < 1 {
+		concurrency = DefaultBatchConcurrency
+	}
+	errs := make([]error, n)
+	slots := make(chan bool, concurrency)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		if err := a.Context().Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		slots <- true
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// resolveIds fetches the static resource at pathFormat (e.g. "quest/%d")
+// for each of ids, once per id, running up to concurrency requests at
+// once (0 means DefaultBatchConcurrency). newValue returns the value to
+// decode each response into. Resources are cached, so resolving them
+// again doesn't make requests. It returns the values that loaded and the
+// errors of those that didn't, keyed by id.
+func (a *ApiClient) resolveIds(ids []int, concurrency int, pathFormat string, newValue func() interface{}) (map[int]interface{}, map[int]error) {
+	unique := make([]int, 0, len(ids))
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	values := make([]interface{}, len(unique))
+	errs := a.forEach(len(unique), concurrency, func(i int) error {
+		value := newValue()
+		err := a.getCached(fmt.Sprintf(pathFormat, unique[i]), nil, value)
+		if err != nil {
+			return err
+		}
+		values[i] = value
+		return nil
+	})
+
+	resolved := make(map[int]interface{})
+	failed := make(map[int]error)
+	for i, id := range unique {
+		if errs[i] != nil {
+			failed[id] = errs[i]
+		} else {
+			resolved[id] = values[i]
+		}
+	}
+	return resolved, failed
+}
+
+// loadErrors returns the errors from resolveIds in order of id, each
+// naming the kind of resource (e.g. "quest") and id that failed.
+func loadErrors(kind string, failed map[int]error) []error {
+	ids := make([]int, 0, len(failed))
+	for id := range failed {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		errs[i] = errors.New(fmt.Sprintf("Could not load %s %d: %s", kind, id, failed[id]))
+	}
+	return errs
+}
@@ -1,5 +1,9 @@
 package wow
 
+import (
+	"math"
+)
+
 type BattlePetSpecies struct {
 	Abilities   []*BattlePetAbility
 	CanBattle   bool
@@ -9,4 +13,89 @@ type BattlePetSpecies struct {
 	PetTypeId   int
 	Source      string
 	SpeciesId   int
+	// Base stats of the species, used by StatsAt. The species endpoint
+	// doesn't return them; GetBattlePetSpeciesWithStats derives them
+	// from the stats endpoint.
+	BaseHealth float64
+	BasePower  float64
+	BaseSpeed  float64
+}
+
+// Stat bonuses of each breed in thousandths, as health, power and
+// speed. Breeds 13-22 are the opposite gender of breeds 3-12 and have
+// the same bonuses.
+var breedStats = map[int][3]int{
+	3:  [3]int{500, 500, 500},
+	4:  [3]int{0, 2000, 0},
+	5:  [3]int{0, 0, 2000},
+	6:  [3]int{2000, 0, 0},
+	7:  [3]int{900, 900, 0},
+	8:  [3]int{0, 900, 900},
+	9:  [3]int{900, 0, 900},
+	10: [3]int{400, 900, 400},
+	11: [3]int{400, 400, 900},
+	12: [3]int{900, 400, 400},
+}
+
+// StatsAt computes the species' stats at the given level (1-25), breed
+// (3-22) and quality (0 for poor through 5 for legendary) without
+// asking the API, using the species' base stats:
+//
+//	health = (base + breed) * 5 * level * quality + 100
+//	power  = (base + breed) * level * quality
+//	speed  = (base + breed) * level * quality
+//
+// where quality is 1 + qualityId/10. Fractions are dropped, matching
+// the stats endpoint. All stats are 0 if the level, breed or quality
+// is out of range.
+func (s *BattlePetSpecies) StatsAt(level int, breedId int, qualityId int) (power int, health int, speed int) {
+	breed, ok := breedStats[breedId]
+	if !ok && breedId > 12 {
+		breed, ok = breedStats[breedId-10]
+	}
+	if !ok || level < 1 || level > 25 || qualityId < 0 || qualityId > 5 {
+		return 0, 0, 0
+	}
+
+	// Work in thousandths and tenths so exact halves aren't subject to
+	// float rounding.
+	stat := func(base float64, bonus int) int {
+		return (int(base*1000+0.5) + bonus) * level * (10 + qualityId) / 10000
+	}
+	health = (int(s.BaseHealth*1000+0.5)+breed[0])*5*level*(10+qualityId)/10000 + 100
+	power = stat(s.BasePower, breed[1])
+	speed = stat(s.BaseSpeed, breed[2])
+	return power, health, speed
+}
+
+// GetBattlePetSpeciesWithStats is GetBattlePetSpecies that also sets
+// the species' base stats for StatsAt, at the cost of a request to the
+// stats endpoint. Species that can't battle have no stats, so their
+// base stats are left at 0 without the request.
+func (a *ApiClient) GetBattlePetSpeciesWithStats(id int) (*BattlePetSpecies, error) {
+	species, err := a.GetBattlePetSpecies(id)
+	if err != nil {
+		return nil, err
+	}
+	if species.CanBattle {
+		pet, err := a.GetBattlePetStats(id, 25, 3, 5)
+		if err != nil {
+			return nil, err
+		}
+		species.setBaseStats(pet)
+	}
+	return species, nil
+}
+
+// setBaseStats derives the species' base stats from pet, its stats at
+// level 25 with breed 3 (which adds 0.5 to each) and legendary quality,
+// where they're precise enough to round to the eighths base stats come
+// in.
+func (s *BattlePetSpecies) setBaseStats(pet *BattlePet) {
+	base := func(stat int, scale float64) float64 {
+		return math.Floor((float64(stat)/scale-0.5)*8+0.5) / 8
+	}
+	s.BaseHealth = base(pet.Health-100, 5*25*1.5)
+	s.BasePower = base(pet.Power, 25*1.5)
+	s.BaseSpeed = base(pet.Speed, 25*1.5)
 }
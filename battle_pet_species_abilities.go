@@ -0,0 +1,31 @@
+package wow
+
+// GetSpeciesWithAbilities fetches the battle pet species and the full
+// details of each of its abilities, in the order the species lists
+// them, without duplicates. Ability lookups are cached and run
+// concurrently; if any fails, the error of the lowest id is returned.
+func (a *ApiClient) GetSpeciesWithAbilities(id int) (*BattlePetSpecies, []*BattlePetAbility, error) {
+	species, err := a.GetBattlePetSpecies(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]int, len(species.Abilities))
+	for i, ability := range species.Abilities {
+		ids[i] = ability.Id
+	}
+	resolved, failed := a.resolveIds(ids, 0, "battlePet/ability/%d", func() interface{} { return &BattlePetAbility{} })
+	if errs := loadErrors("battle pet ability", failed); len(errs) > 0 {
+		return nil, nil, errs[0]
+	}
+
+	abilities := make([]*BattlePetAbility, 0, len(resolved))
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			abilities = append(abilities, resolved[id].(*BattlePetAbility))
+		}
+	}
+	return species, abilities, nil
+}
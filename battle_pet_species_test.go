@@ -0,0 +1,99 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type BattlePetSpeciesSuite struct{}
+
+var _ = Suite(&BattlePetSpeciesSuite{})
+
+func (s *BattlePetSpeciesSuite) Test_StatsAt(c *C) {
+	// Species 258 at level 25, breed 5 (S/S), epic quality is 315 power,
+	// 1587 health and 297 speed according to the stats endpoint.
+	species := &BattlePetSpecies{SpeciesId: 258, BaseHealth: 8.5, BasePower: 9, BaseSpeed: 6.5}
+	power, health, speed := species.StatsAt(25, 5, 4)
+	c.Assert(power, Equals, 315)
+	c.Assert(health, Equals, 1587)
+	c.Assert(speed, Equals, 297)
+
+	// Breed 15 is the female variant of breed 5.
+	power2, health2, speed2 := species.StatsAt(25, 15, 4)
+	c.Assert([]int{power2, health2, speed2}, DeepEquals, []int{power, health, speed})
+
+	power, health, speed = species.StatsAt(1, 3, 1)
+	c.Assert([]int{power, health, speed}, DeepEquals, []int{10, 149, 7})
+}
+
+func (s *BattlePetSpeciesSuite) Test_StatsAt_invalid(c *C) {
+	species := &BattlePetSpecies{BaseHealth: 8.5, BasePower: 9, BaseSpeed: 6.5}
+	power, health, speed := species.StatsAt(26, 3, 1)
+	c.Assert([]int{power, health, speed}, DeepEquals, []int{0, 0, 0})
+	power, health, speed = species.StatsAt(25, 2, 1)
+	c.Assert([]int{power, health, speed}, DeepEquals, []int{0, 0, 0})
+	power, health, speed = species.StatsAt(25, 3, 6)
+	c.Assert([]int{power, health, speed}, DeepEquals, []int{0, 0, 0})
+}
+
+func (s *BattlePetSpeciesSuite) Test_GetBattlePetSpeciesWithStats(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/battlePet/species/258":
+			w.Write([]byte(`{"speciesId": 258, "canBattle": true}`))
+		case "/wow/battlePet/stats/258":
+			c.Check(r.URL.Query().Get("level"), Equals, "25")
+			c.Check(r.URL.Query().Get("breedId"), Equals, "3")
+			c.Check(r.URL.Query().Get("qualityId"), Equals, "5")
+			w.Write([]byte(`{"speciesId": 258, "breedId": 3, "petQualityId": 5, "level": 25, "health": 1787, "power": 356, "speed": 262}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	species, err := client.GetBattlePetSpecies(258)
+	c.Assert(err, IsNil)
+	c.Assert(species.BaseHealth, Equals, 0.0)
+
+	species, err = client.GetBattlePetSpeciesWithStats(258)
+	c.Assert(err, IsNil)
+	c.Assert(species.BaseHealth, Equals, 8.5)
+	c.Assert(species.BasePower, Equals, 9.0)
+	c.Assert(species.BaseSpeed, Equals, 6.5)
+	power, health, speed := species.StatsAt(25, 5, 4)
+	c.Assert([]int{power, health, speed}, DeepEquals, []int{315, 1587, 297})
+}
+
+func (s *BattlePetSpeciesSuite) Test_GetSpeciesWithAbilities(c *C) {
+	abilityRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/battlePet/species/258":
+			w.Write([]byte(`{"speciesId": 258, "petTypeId": 6, "creatureId": 42078, "canBattle": true, "abilities": [{"slot": 0, "order": 0, "requiredLevel": 1, "id": 640}, {"slot": 1, "order": 1, "requiredLevel": 2, "id": 210}, {"slot": 0, "order": 3, "requiredLevel": 10, "id": 640}]}`))
+		case "/wow/battlePet/ability/640":
+			abilityRequests++
+			w.Write([]byte(`{"id": 640, "name": "Toxic Smoke", "cooldown": 0, "rounds": 1, "petTypeId": 9}`))
+		case "/wow/battlePet/ability/210":
+			abilityRequests++
+			w.Write([]byte(`{"id": 210, "name": "Shadow Slash", "cooldown": 0, "rounds": 1, "petTypeId": 5}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	species, abilities, err := client.GetSpeciesWithAbilities(258)
+	c.Assert(err, IsNil)
+	c.Assert(species.CreatureId, Equals, 42078)
+	c.Assert(len(abilities), Equals, 2)
+	c.Assert(abilities[0].Name, Equals, "Toxic Smoke")
+	c.Assert(abilities[1].Name, Equals, "Shadow Slash")
+
+	_, _, err = client.GetSpeciesWithAbilities(258)
+	c.Assert(err, IsNil)
+	c.Assert(abilityRequests, Equals, 2)
+}
@@ -4,3 +4,7 @@ type Battlegroup struct {
 	Name string
 	Slug string
 }
+
+func (b *Battlegroup) GetName() string {
+	return b.Name
+}
@@ -0,0 +1,70 @@
+package wow
+
+import (
+	"sync"
+)
+
+// memoryCache holds responses that don't change between requests, such
+// as data resources. A nil *memoryCache never stores anything.
+type memoryCache struct {
+	mutex   sync.Mutex
+	entries map[string]interface{}
+	hits    int64
+	misses  int64
+}
+
+// CacheStats counts the lookups of the client's cache. Entries are
+// never evicted, so misses are first lookups of a resource (or
+// lookups of resources that failed to load).
+type CacheStats struct {
+	Hits    int64
+	Misses  int64
+	Entries int
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{entries: make(map[string]interface{})}
+}
+
+func (m *memoryCache) get(key string) (interface{}, bool) {
+	if m == nil {
+		return nil, false
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	value, ok := m.entries[key]
+	if ok {
+		m.hits++
+	} else {
+		m.misses++
+	}
+	return value, ok
+}
+
+func (m *memoryCache) set(key string, value interface{}) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.entries[key] = value
+}
+
+func (m *memoryCache) stats() CacheStats {
+	if m == nil {
+		return CacheStats{}
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return CacheStats{
+		Hits:    m.hits,
+		Misses:  m.misses,
+		Entries: len(m.entries),
+	}
+}
+
+// CacheStats returns the counters of the client's cache, which is
+// shared with the copies made by WithContext.
+func (a *ApiClient) CacheStats() CacheStats {
+	return a.state().cache.stats()
+}
@@ -0,0 +1,30 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type CacheSuite struct{}
+
+var _ = Suite(&CacheSuite{})
+
+func (s *CacheSuite) Test_CacheStats(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"races": [], "classes": []}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	events := make([]EventType, 0)
+	client.Observer = ObserverFunc(func(event *Event) {
+		events = append(events, event.Type)
+	})
+
+	client.GetRaces()
+	client.GetRaces()
+	client.GetClasses()
+	c.Assert(client.CacheStats(), Equals, CacheStats{Hits: 1, Misses: 2, Entries: 2})
+	c.Assert(events, DeepEquals, []EventType{CacheMissEvent, CacheHitEvent, CacheMissEvent})
+	c.Assert(CacheHitEvent.String(), Equals, "cache hit")
+}
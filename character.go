@@ -3,6 +3,8 @@ package wow
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
 type Character struct {
@@ -11,6 +13,7 @@ type Character struct {
 	ClassId             int `json:"class"`
 	class               string
 	CalcClass           string
+	Faction             int
 	Gender              int
 	Level               int
 	Name                string
@@ -38,6 +41,41 @@ type Character struct {
 	ApiClient           *ApiClient
 }
 
+// ErrBelowLevel is returned by GetCharacterAtLeast when the character
+// is below the requested level.
+type ErrBelowLevel struct {
+	Name     string
+	Level    int
+	MinLevel int
+}
+
+func (e *ErrBelowLevel) Error() string {
+	return fmt.Sprintf("%s is level %d, below the minimum of %d", e.Name, e.Level, e.MinLevel)
+}
+
+// ProfileHiddenError is returned when a character exists but its
+// owner has opted out of the armory, so its profile can't be fetched.
+type ProfileHiddenError struct {
+	Realm  string
+	Name   string
+	Reason string
+}
+
+func (e *ProfileHiddenError) Error() string {
+	return fmt.Sprintf("The profile of %s-%s is hidden: %s", e.Name, e.Realm, e.Reason)
+}
+
+// characterError turns the API's refusal to return a hidden profile,
+// e.g. a 403 with the reason "This character's profile is not
+// available.", into a *ProfileHiddenError.
+func characterError(realm string, name string, err error) error {
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) && strings.Contains(strings.ToLower(apiErr.Reason), "not available") {
+		return &ProfileHiddenError{Realm: realm, Name: name, Reason: apiErr.Reason}
+	}
+	return err
+}
+
 func NewCharacter(client *ApiClient) *Character {
 	return &Character{ApiClient: client}
 }
@@ -68,3 +106,62 @@ func (c *Character) Class() (string, error) {
 	return c.class, nil
 
 }
+
+// LastModifiedTime returns LastModified, a timestamp in milliseconds,
+// as a time. It's roughly when the character last logged out.
+func (c *Character) LastModifiedTime() time.Time {
+	return time.Unix(0, int64(c.LastModified)*int64(time.Millisecond))
+}
+
+// FormattedTitles renders each of the character's titles with its
+// name, in the same order as Titles, so Titles[i].Selected tells which
+// one is displayed in game. It is empty unless the "titles" field was
+// requested.
+func (c *Character) FormattedTitles() []string {
+	titles := make([]string, 0, len(c.Titles))
+	for _, title := range c.Titles {
+		titles = append(titles, title.Format(c.Name))
+	}
+	return titles
+}
+
+// SelectedTitle renders the character's selected title, or returns
+// the bare name if no title is selected or titles weren't requested.
+func (c *Character) SelectedTitle() string {
+	for _, title := range c.Titles {
+		if title.Selected {
+			return title.Format(c.Name)
+		}
+	}
+	return c.Name
+}
+
+// ActiveSpec returns the spec of the character's selected talents, or
+// nil if the "talents" field wasn't requested or no spec is chosen.
+func (c *Character) ActiveSpec() *Spec {
+	for _, talents := range c.Talents {
+		if talents.Selected {
+			return talents.Spec
+		}
+	}
+	return nil
+}
+
+// ActiveSpecId returns the specialization id of ActiveSpec, or false if
+// it's unknown.
+func (c *Character) ActiveSpecId() (int, bool) {
+	spec := c.ActiveSpec()
+	if spec == nil {
+		return 0, false
+	}
+	return SpecId(c.ClassId, spec.Order)
+}
+
+// ActiveRole returns the role of ActiveSpec.
+func (c *Character) ActiveRole() Role {
+	spec := c.ActiveSpec()
+	if spec == nil {
+		return RoleUnknown
+	}
+	return ParseRole(spec.Role)
+}
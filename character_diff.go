@@ -0,0 +1,74 @@
+package wow
+
+// CharacterDiff describes what changed between two snapshots of the
+// same character. Changes that depend on a field (e.g. "items" or
+// "achievements") are only reported when both snapshots include it.
+type CharacterDiff struct {
+	LevelChange             int
+	AchievementPointsChange int
+	ItemLevelChange         int
+	// Ids of achievements completed in the new snapshot but not the
+	// old one.
+	NewAchievements  []int
+	EquipmentChanges []*EquipmentChange
+}
+
+// EquipmentChange is a slot whose equipped item changed. Old or New is
+// nil if the slot was empty.
+type EquipmentChange struct {
+	Slot string
+	Old  *Item
+	New  *Item
+}
+
+// HasChanges reports whether anything changed.
+func (d *CharacterDiff) HasChanges() bool {
+	return d.LevelChange != 0 ||
+		d.AchievementPointsChange != 0 ||
+		d.ItemLevelChange != 0 ||
+		len(d.NewAchievements) > 0 ||
+		len(d.EquipmentChanges) > 0
+}
+
+// DiffCharacters compares two snapshots of a character. Either may be
+// nil, in which case it's treated as an empty character.
+func DiffCharacters(old *Character, new *Character) *CharacterDiff {
+	if old == nil {
+		old = &Character{}
+	}
+	if new == nil {
+		new = &Character{}
+	}
+	diff := &CharacterDiff{
+		LevelChange:             new.Level - old.Level,
+		AchievementPointsChange: new.AchievementPoints - old.AchievementPoints,
+		NewAchievements:         make([]int, 0),
+		EquipmentChanges:        make([]*EquipmentChange, 0),
+	}
+
+	if old.Achievements != nil && new.Achievements != nil {
+		completed := make(map[int]bool)
+		for _, id := range old.Achievements.AchievementsCompleted {
+			completed[id] = true
+		}
+		for _, id := range new.Achievements.AchievementsCompleted {
+			if !completed[id] {
+				diff.NewAchievements = append(diff.NewAchievements, id)
+			}
+		}
+	}
+
+	if old.Items != nil && new.Items != nil {
+		diff.ItemLevelChange = new.Items.AverageItemLevel - old.Items.AverageItemLevel
+		for _, slot := range itemSlots {
+			oldItem, newItem := old.Items.Slot(slot), new.Items.Slot(slot)
+			if oldItem == nil && newItem == nil {
+				continue
+			}
+			if oldItem == nil || newItem == nil || oldItem.Id != newItem.Id {
+				diff.EquipmentChanges = append(diff.EquipmentChanges, &EquipmentChange{Slot: slot, Old: oldItem, New: newItem})
+			}
+		}
+	}
+	return diff
+}
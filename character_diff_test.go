@@ -0,0 +1,42 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type CharacterDiffSuite struct{}
+
+var _ = Suite(&CharacterDiffSuite{})
+
+func (s *CharacterDiffSuite) Test_DiffCharacters(c *C) {
+	old := &Character{
+		Level:             89,
+		AchievementPoints: 100,
+		Achievements:      &AchievementList{AchievementsCompleted: []int{6, 7}},
+		Items:             &ItemList{AverageItemLevel: 450, Head: &Item{Id: 1}, Neck: &Item{Id: 2}},
+	}
+	new := &Character{
+		Level:             90,
+		AchievementPoints: 110,
+		Achievements:      &AchievementList{AchievementsCompleted: []int{6, 7, 8}},
+		Items:             &ItemList{AverageItemLevel: 463, Head: &Item{Id: 3}, Neck: &Item{Id: 2}, Feet: &Item{Id: 4}},
+	}
+
+	d := DiffCharacters(old, new)
+	c.Assert(d.HasChanges(), Equals, true)
+	c.Assert(d.LevelChange, Equals, 1)
+	c.Assert(d.AchievementPointsChange, Equals, 10)
+	c.Assert(d.ItemLevelChange, Equals, 13)
+	c.Assert(d.NewAchievements, DeepEquals, []int{8})
+	c.Assert(len(d.EquipmentChanges), Equals, 2)
+	c.Assert(d.EquipmentChanges[0].Slot, Equals, "head")
+	c.Assert(d.EquipmentChanges[0].Old.Id, Equals, 1)
+	c.Assert(d.EquipmentChanges[1].Slot, Equals, "feet")
+	c.Assert(d.EquipmentChanges[1].Old, IsNil)
+}
+
+func (s *CharacterDiffSuite) Test_DiffCharacters_partial(c *C) {
+	d := DiffCharacters(&Character{Level: 90}, &Character{Level: 90, Items: &ItemList{Head: &Item{Id: 1}}})
+	c.Assert(d.HasChanges(), Equals, false)
+	c.Assert(DiffCharacters(nil, nil).HasChanges(), Equals, false)
+}
@@ -1,7 +1,12 @@
 package wow
 
 import (
+	"encoding/json"
 	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
 )
 
 type CharacterSuite struct{}
@@ -27,3 +32,128 @@ func (s *CharacterSuite) Test_CharacterClass(c *C) {
 	class, _ := ch.Class()
 	c.Assert(class, Equals, "Death Knight")
 }
+
+func (s *CharacterSuite) Test_FormattedTitles(c *C) {
+	ch := &Character{Name: "Capoferro", Titles: []*Title{
+		&Title{Id: 1, Name: "%s the Explorer"},
+		&Title{Id: 2, Name: "Private %s", Selected: true},
+	}}
+	c.Assert(ch.FormattedTitles(), DeepEquals, []string{"Capoferro the Explorer", "Private Capoferro"})
+	c.Assert(ch.SelectedTitle(), Equals, "Private Capoferro")
+	c.Assert((&Character{Name: "Capoferro"}).FormattedTitles(), DeepEquals, []string{})
+}
+
+func (s *CharacterSuite) Test_ActiveSpec(c *C) {
+	ch := &Character{ClassId: 10, Talents: []*CharacterTalentList{
+		&CharacterTalentList{Spec: &Spec{Name: "Brewmaster", Order: 0, Role: "TANK"}},
+		&CharacterTalentList{Selected: true, Spec: &Spec{Name: "Mistweaver", Order: 1, Role: "HEALING"}},
+	}}
+	c.Assert(ch.ActiveSpec().Name, Equals, "Mistweaver")
+	c.Assert(ch.ActiveRole(), Equals, RoleHealer)
+	id, ok := ch.ActiveSpecId()
+	c.Assert(ok, Equals, true)
+	c.Assert(id, Equals, 270)
+	c.Assert((&Character{}).ActiveRole(), Equals, RoleUnknown)
+}
+
+func (s *CharacterSuite) Test_Character_baseFields(c *C) {
+	jsonBlob := []byte(`{"lastModified": 1400000000000, "name": "Capoferro", "realm": "Runetotem", "battlegroup": "Vindication", "class": 4, "race": 1, "gender": 0, "level": 90, "achievementPoints": 10505, "thumbnail": "runetotem/160/43600288-avatar.jpg", "calcClass": "c", "faction": 0, "totalHonorableKills": 1520}`)
+	ch := &Character{}
+	err := json.Unmarshal(jsonBlob, ch)
+	c.Assert(err, IsNil)
+	c.Assert(ch.Name, Equals, "Capoferro")
+	c.Assert(ch.Battlegroup, Equals, "Vindication")
+	c.Assert(ch.ClassId, Equals, 4)
+	c.Assert(ch.AchievementPoints, Equals, 10505)
+	c.Assert(ch.Thumbnail, Equals, "runetotem/160/43600288-avatar.jpg")
+	c.Assert(ch.CalcClass, Equals, "c")
+	c.Assert(ch.Faction, Equals, 0)
+	c.Assert(ch.TotalHonorableKills, Equals, 1520)
+	c.Assert(ch.LastModifiedTime().Equal(time.Unix(1400000000, 0)), Equals, true)
+}
+
+func (s *CharacterSuite) Test_GetCharacter_hiddenProfile(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/Hidden") {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"status": "nok", "reason": "This character's profile is not available."}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status": "nok", "reason": "Character not found."}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	_, err := client.GetCharacter("runetotem", "Hidden")
+	hidden, ok := err.(*ProfileHiddenError)
+	c.Assert(ok, Equals, true)
+	c.Assert(hidden.Name, Equals, "Hidden")
+	c.Assert(err.Error(), Equals, "The profile of Hidden-runetotem is hidden: This character's profile is not available.")
+
+	_, err = client.GetCharacter("runetotem", "Nobody")
+	_, ok = err.(*ProfileHiddenError)
+	c.Assert(ok, Equals, false)
+	c.Assert(err.(*ApiError).Reason, Equals, "Character not found.")
+}
+
+func (s *CharacterSuite) Test_GetCharacter_retryIncomplete(c *C) {
+	responses := []string{``, `{"name": "Capo`, `{"name": "Capoferro"}`}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(responses[requests%len(responses)]))
+		requests++
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	_, err := client.GetCharacter("runetotem", "Capoferro")
+	c.Assert(err, NotNil)
+	c.Assert(requests, Equals, 1)
+
+	client.RetryIncompleteCharacters = true
+	requests = 1
+	ch, err := client.GetCharacter("runetotem", "Capoferro")
+	c.Assert(err, IsNil)
+	c.Assert(ch.Name, Equals, "Capoferro")
+	c.Assert(requests, Equals, 3)
+
+	// Only one retry.
+	requests = 0
+	_, err = client.GetCharacter("runetotem", "Capoferro")
+	c.Assert(err, NotNil)
+	c.Assert(requests, Equals, 2)
+}
+
+func (s *CharacterSuite) Test_isIncompleteJson(c *C) {
+	c.Assert(isIncompleteJson([]byte(``)), Equals, true)
+	c.Assert(isIncompleteJson([]byte(`{"name": [1, 2`)), Equals, true)
+	c.Assert(isIncompleteJson([]byte(`{"name": }`)), Equals, false)
+	c.Assert(isIncompleteJson([]byte(`{"name": "Capoferro"}`)), Equals, false)
+}
+
+func (s *CharacterSuite) Test_GetCharacterWithFields_warnings(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "Capoferro", "realm": "Runetotem", "level": 100,
+			"mounts": {"numCollected": 0, "numNotCollected": 0, "collected": []},
+			"titles": [],
+			"progression": {},
+			"feed": null}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	var warnings []Warning
+	fields := []string{"titles", "mounts", "feed", "pets", "progression"}
+	char, err := client.GetCharacterWithFields("Runetotem", "Capoferro", fields, WithWarnings(&warnings))
+	c.Assert(err, IsNil)
+	c.Assert(char.Name, Equals, "Capoferro")
+	c.Assert(warnings, DeepEquals, []Warning{
+		Warning{Field: "feed"},
+		Warning{Field: "pets", Missing: true},
+		Warning{Field: "progression"},
+		Warning{Field: "titles"},
+	})
+	c.Assert(warnings[1].String(), Equals, "Field 'pets' is missing")
+	c.Assert(warnings[0].String(), Equals, "Field 'feed' is empty")
+}
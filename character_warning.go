@@ -0,0 +1,76 @@
+package wow
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Warning notes a requested character field that the API left out or
+// returned empty, as it does for inactive characters.
+type Warning struct {
+	Field string
+	// Missing is set if the field wasn't in the response at all, and
+	// unset if it was there but empty.
+	Missing bool
+}
+
+func (w Warning) String() string {
+	if w.Missing {
+		return fmt.Sprintf("Field '%s' is missing", w.Field)
+	}
+	return fmt.Sprintf("Field '%s' is empty", w.Field)
+}
+
+// CharacterOption configures one call of GetCharacterWithFields.
+type CharacterOption func(o *characterOptions)
+
+type characterOptions struct {
+	warnings *[]Warning
+}
+
+// WithWarnings sets *warnings to a Warning for each requested field the
+// response doesn't fill in, so crawlers can tell incomplete profiles
+// from complete ones.
+func WithWarnings(warnings *[]Warning) CharacterOption {
+	return func(o *characterOptions) {
+		o.warnings = warnings
+	}
+}
+
+func characterWarnings(jsonBlob []byte, fields []string) ([]Warning, error) {
+	values := make(map[string]json.RawMessage)
+	err := json.Unmarshal(jsonBlob, &values)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, _ = validateCharacterFields(fields)
+	warnings := make([]Warning, 0)
+	for _, field := range fields {
+		value, ok := values[field]
+		if !ok {
+			warnings = append(warnings, Warning{Field: field, Missing: true})
+		} else if isEmptyJson(value) {
+			warnings = append(warnings, Warning{Field: field})
+		}
+	}
+	return warnings, nil
+}
+
+// isEmptyJson reports whether value is null, an empty array or an
+// object with no entries. Zeros and false are values like any other.
+func isEmptyJson(value json.RawMessage) bool {
+	var v interface{}
+	if json.Unmarshal(value, &v) != nil {
+		return false
+	}
+	switch v := v.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
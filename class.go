@@ -6,3 +6,7 @@ type Class struct {
 	PowerType string
 	Name      string
 }
+
+func (c *Class) GetName() string {
+	return c.Name
+}
@@ -1,15 +1,45 @@
 package wow
 
-type ClassTalentList struct {
-	Warrior     *TalentList `json:"1"`
-	Paladin     *TalentList `json:"2"`
-	Hunter      *TalentList `json:"3"`
-	Rogue       *TalentList `json:"4"`
-	Priest      *TalentList `json:"5"`
-	Deathknight *TalentList `json:"6"`
-	Shaman      *TalentList `json:"7"`
-	Mage        *TalentList `json:"8"`
-	Warlock     *TalentList `json:"9"`
-	Monk        *TalentList `json:"10"`
-	Druid       *TalentList `json:"11"`
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ClassTalentList holds the talents of every class, keyed by class id
+// as the API does, e.g. "8" for mages. Classes added after this package
+// (Demon Hunters are "12") are included like any other.
+type ClassTalentList map[string]*TalentList
+
+// ByClassId returns the talent lists keyed by class id.
+func (l ClassTalentList) ByClassId() map[int]*TalentList {
+	byClassId := make(map[int]*TalentList, len(l))
+	for key, list := range l {
+		if classId, err := strconv.Atoi(key); err == nil {
+			byClassId[classId] = list
+		}
+	}
+	return byClassId
+}
+
+// GetClassTalents returns the talent list of the class with the given
+// id from the talents data resource, which is cached. Only that class
+// is decoded. The id is valid if the resource has talents for it.
+func (a *ApiClient) GetClassTalents(classId int) (*TalentList, error) {
+	classes := make(map[string]json.RawMessage)
+	err := a.getCached("data/talents", nil, &classes)
+	if err != nil {
+		return nil, err
+	}
+	jsonBlob, ok := classes[strconv.Itoa(classId)]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Class id %d is not valid", classId))
+	}
+	talents := &TalentList{}
+	err = json.Unmarshal(jsonBlob, talents)
+	if err != nil {
+		return nil, err
+	}
+	return talents, nil
 }
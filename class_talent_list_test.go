@@ -0,0 +1,46 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type ClassTalentListSuite struct{}
+
+var _ = Suite(&ClassTalentListSuite{})
+
+func (s *ClassTalentListSuite) Test_GetClassTalents(c *C) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		c.Check(r.URL.Path, Equals, "/wow/data/talents")
+		w.Write([]byte(`{
+			"8": {"class": "mage", "talents": [[{"tier": 0, "column": 0, "spell": {"id": 212653, "name": "Shimmer"}}]], "specs": [{"name": "Arcane", "role": "DPS", "order": 0}]},
+			"1": {"class": "warrior", "talents": [], "specs": []},
+			"12": {"class": "demon-hunter", "talents": [], "specs": [{"name": "Vengeance", "role": "TANK", "order": 1}]}
+		}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	mage, err := client.GetClassTalents(8)
+	c.Assert(err, IsNil)
+	c.Assert(mage.Class, Equals, "mage")
+	c.Assert(mage.Talents[0][0].Spell.Name, Equals, "Shimmer")
+	c.Assert(len(mage.Specs), Equals, 1)
+	c.Assert(mage.Specs[0].Name, Equals, "Arcane")
+
+	warrior, err := client.GetClassTalents(1)
+	c.Assert(err, IsNil)
+	c.Assert(warrior.Class, Equals, "warrior")
+	demonHunter, err := client.GetClassTalents(12)
+	c.Assert(err, IsNil)
+	c.Assert(demonHunter.Specs[0].Name, Equals, "Vengeance")
+	c.Assert(requests, Equals, 1)
+
+	_, err = client.GetClassTalents(2)
+	c.Assert(err, ErrorMatches, "Class id 2 is not valid")
+	_, err = client.GetClassTalents(42)
+	c.Assert(err, ErrorMatches, "Class id 42 is not valid")
+}
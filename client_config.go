@@ -0,0 +1,138 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ClientConfig is the configuration of an ApiClient without its
+// credentials, so it can be saved as JSON and shared. The retry
+// settings are pointers so that a saved zero, e.g. retries turned off,
+// isn't mistaken for a missing setting; nil means the default.
+type ClientConfig struct {
+	Region                    string         `json:"region"`
+	Locale                    string         `json:"locale"`
+	Host                      string         `json:"host,omitempty"`
+	Scheme                    string         `json:"scheme,omitempty"`
+	BasePath                  string         `json:"basePath,omitempty"`
+	TokenURL                  string         `json:"tokenUrl,omitempty"`
+	AuctionFilesHost          string         `json:"auctionFilesHost,omitempty"`
+	MaxAuctionBytes           int64          `json:"maxAuctionBytes,omitempty"`
+	Timeout                   time.Duration  `json:"timeout,omitempty"`
+	DefaultCharacterFields    []string       `json:"defaultCharacterFields,omitempty"`
+	TrackDeprecations         bool           `json:"trackDeprecations,omitempty"`
+	ResolveConnectedRealms    bool           `json:"resolveConnectedRealms,omitempty"`
+	RetryIncompleteCharacters bool           `json:"retryIncompleteCharacters,omitempty"`
+	GenerateRequestIds        bool           `json:"generateRequestIds,omitempty"`
+	MaxRetries                *int           `json:"maxRetries,omitempty"`
+	RetryBackoff              *time.Duration `json:"retryBackoff,omitempty"`
+	MaxRetryAfter             *time.Duration `json:"maxRetryAfter,omitempty"`
+	// RequestsPerSecond is the rate of a Limiter made by
+	// NewRateLimiter or WithRateLimit; other Limiters aren't saved.
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+}
+
+// Option configures an ApiClient made by NewApiClientFromConfig. An
+// option that can't be applied returns an error, which
+// NewApiClientFromConfig returns.
+type Option func(a *ApiClient) error
+
+// WithCredentials sets the client's PublicKey and Secret.
+func WithCredentials(publicKey string, secret string) Option {
+	return func(a *ApiClient) error {
+		a.PublicKey = publicKey
+		a.Secret = secret
+		return nil
+	}
+}
+
+// Config returns the client's configuration. The timeout is only known
+// if HttpClient is an *http.Client, and the rate limit if Limiter was
+// made by NewRateLimiter.
+func (a *ApiClient) Config() ClientConfig {
+	maxRetries := a.MaxRetries
+	retryBackoff := a.RetryBackoff
+	maxRetryAfter := a.MaxRetryAfter
+	config := ClientConfig{
+		Region:                    a.region,
+		Locale:                    a.Locale,
+		Host:                      a.Host,
+		Scheme:                    a.Scheme,
+		BasePath:                  a.BasePath,
+		TokenURL:                  a.TokenURL,
+		AuctionFilesHost:          a.AuctionFilesHost,
+		MaxAuctionBytes:           a.MaxAuctionBytes,
+		DefaultCharacterFields:    a.defaultCharacterFields,
+		TrackDeprecations:         a.TrackDeprecations,
+		ResolveConnectedRealms:    a.ResolveConnectedRealms,
+		RetryIncompleteCharacters: a.RetryIncompleteCharacters,
+		GenerateRequestIds:        a.GenerateRequestIds,
+		MaxRetries:                &maxRetries,
+		RetryBackoff:              &retryBackoff,
+		MaxRetryAfter:             &maxRetryAfter,
+	}
+	if httpClient, ok := a.HttpClient.(*http.Client); ok {
+		config.Timeout = httpClient.Timeout
+	}
+	if limiter, ok := a.Limiter.(*intervalLimiter); ok {
+		config.RequestsPerSecond = limiter.perSecond
+	}
+	return config
+}
+
+// NewApiClientFromConfig makes a client from a configuration returned
+// by Config, then applies opts, e.g. WithCredentials.
+func NewApiClientFromConfig(config ClientConfig, opts ...Option) (*ApiClient, error) {
+	client, err := NewApiClient(config.Region, config.Locale)
+	if err != nil {
+		return nil, err
+	}
+	err = client.SetDefaultCharacterFields(config.DefaultCharacterFields)
+	if err != nil {
+		return nil, err
+	}
+	if config.Host != "" {
+		client.Host = config.Host
+	}
+	if config.Scheme != "" {
+		client.Scheme = config.Scheme
+	}
+	if config.Timeout != 0 {
+		client.HttpClient = &http.Client{Timeout: config.Timeout}
+	}
+	if config.MaxRetries != nil {
+		client.MaxRetries = *config.MaxRetries
+	}
+	if config.RetryBackoff != nil {
+		client.RetryBackoff = *config.RetryBackoff
+	}
+	if config.MaxRetryAfter != nil {
+		client.MaxRetryAfter = *config.MaxRetryAfter
+	}
+	if config.RequestsPerSecond < 0 {
+		return nil, errors.New(fmt.Sprintf("Requests per second %v is not valid", config.RequestsPerSecond))
+	}
+	if config.RequestsPerSecond != 0 {
+		client.Limiter, err = NewRateLimiter(config.RequestsPerSecond)
+		if err != nil {
+			return nil, err
+		}
+	}
+	client.BasePath = config.BasePath
+	client.TokenURL = config.TokenURL
+	client.AuctionFilesHost = config.AuctionFilesHost
+	client.MaxAuctionBytes = config.MaxAuctionBytes
+	client.TrackDeprecations = config.TrackDeprecations
+	client.ResolveConnectedRealms = config.ResolveConnectedRealms
+	client.RetryIncompleteCharacters = config.RetryIncompleteCharacters
+	client.GenerateRequestIds = config.GenerateRequestIds
+	for _, opt := range opts {
+		err = opt(client)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
@@ -0,0 +1,77 @@
+package wow
+
+import (
+	"encoding/json"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type ClientConfigSuite struct{}
+
+var _ = Suite(&ClientConfigSuite{})
+
+func (s *ClientConfigSuite) Test_Config_roundTrip(c *C) {
+	client, _ := NewApiClient("EU", "de_DE")
+	client.Secret = "secret"
+	client.PublicKey = "public"
+	client.HttpClient = &http.Client{Timeout: 5 * time.Second}
+	client.ResolveConnectedRealms = true
+	client.AuctionFilesHost = "auctions.example.com"
+	client.SetDefaultCharacterFields([]string{"items", "guild"})
+	client.Scheme = "http"
+	client.Host = "localhost:8080"
+	client.BasePath = "/api/wow/"
+	client.TokenURL = "http://localhost:8080/oauth/token"
+	client.MaxAuctionBytes = 1 << 20
+	client.TrackDeprecations = true
+	client.RetryIncompleteCharacters = true
+	client.GenerateRequestIds = true
+	client.MaxRetries = 0
+	client.RetryBackoff = 2 * time.Second
+	client.MaxRetryAfter = 30 * time.Second
+	client.Limiter, _ = NewRateLimiter(3)
+
+	jsonBlob, err := json.Marshal(client.Config())
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(jsonBlob), "secret"), Equals, false)
+	c.Assert(strings.Contains(string(jsonBlob), "public"), Equals, false)
+
+	config := ClientConfig{}
+	err = json.Unmarshal(jsonBlob, &config)
+	c.Assert(err, IsNil)
+	reloaded, err := NewApiClientFromConfig(config, WithCredentials("public", "secret"))
+	c.Assert(err, IsNil)
+	c.Assert(reloaded.Config(), DeepEquals, client.Config())
+	c.Assert(reloaded.Locale, Equals, "de_DE")
+	c.Assert(reloaded.Host, Equals, "localhost:8080")
+	c.Assert(reloaded.BasePath, Equals, "/api/wow/")
+	c.Assert(reloaded.MaxRetries, Equals, 0)
+	c.Assert(reloaded.RetryBackoff, Equals, 2*time.Second)
+	c.Assert(reloaded.Limiter.(*intervalLimiter).interval, Equals, client.Limiter.(*intervalLimiter).interval)
+	c.Assert(reloaded.Secret, Equals, "secret")
+	c.Assert(reloaded.PublicKey, Equals, "public")
+}
+
+func (s *ClientConfigSuite) Test_NewApiClientFromConfig_defaults(c *C) {
+	client, err := NewApiClientFromConfig(ClientConfig{Region: "US"})
+	c.Assert(err, IsNil)
+	c.Assert(client.MaxRetries, Equals, DefaultMaxRetries)
+	c.Assert(client.RetryBackoff, Equals, DefaultRetryBackoff)
+	c.Assert(client.MaxRetryAfter, Equals, DefaultMaxRetryAfter)
+	c.Assert(client.Limiter, IsNil)
+}
+
+func (s *ClientConfigSuite) Test_NewApiClientFromConfig_invalid(c *C) {
+	_, err := NewApiClientFromConfig(ClientConfig{Region: "EU", Locale: "en_US"})
+	c.Assert(err.Error(), Equals, "Locale 'en_US' is not valid for region 'EU'")
+	_, err = NewApiClientFromConfig(ClientConfig{Region: "US", DefaultCharacterFields: []string{"bogus"}})
+	c.Assert(err.Error(), Equals, "The following fields are not valid: [bogus]")
+
+	config := ClientConfig{}
+	err = json.Unmarshal([]byte(`{"region": "US", "requestsPerSecond": -1}`), &config)
+	c.Assert(err, IsNil)
+	_, err = NewApiClientFromConfig(config)
+	c.Assert(err.Error(), Equals, "Requests per second -1 is not valid")
+}
@@ -0,0 +1,35 @@
+package wow
+
+import (
+	"net/http"
+	"sync"
+)
+
+// clientState is the mutable state an ApiClient shares with the copies
+// made of it by WithContext.
+type clientState struct {
+	mutex        sync.Mutex
+	cache        *memoryCache
+	deprecations map[string]*Deprecation
+	lastHeaders  http.Header
+}
+
+func newClientState() *clientState {
+	return &clientState{
+		cache:        newMemoryCache(),
+		deprecations: make(map[string]*Deprecation),
+	}
+}
+
+var clientStateMutex sync.Mutex
+
+// state returns the client's shared state, creating it for clients
+// that weren't built with NewApiClient.
+func (a *ApiClient) state() *clientState {
+	clientStateMutex.Lock()
+	defer clientStateMutex.Unlock()
+	if a.shared == nil {
+		a.shared = newClientState()
+	}
+	return a.shared
+}
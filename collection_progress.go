@@ -0,0 +1,71 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CategoryProgress is how much of one kind of collectible a character
+// has collected.
+type CategoryProgress struct {
+	Collected int
+	Total     int
+}
+
+// Percent returns Collected as a percentage of Total, or 0 if Total is.
+func (p CategoryProgress) Percent() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return float64(p.Collected) * 100 / float64(p.Total)
+}
+
+// CollectionProgress is a character's collection completion. Titles
+// only has a count, since the API has no master list of titles, and
+// isn't part of Overall.
+type CollectionProgress struct {
+	Mounts  CategoryProgress
+	Pets    CategoryProgress
+	Titles  int
+	Overall CategoryProgress
+}
+
+// CollectionCompletion compares a character's mounts and pets with
+// ref. The character must have been fetched with the "mounts" and
+// "pets" fields; "titles" is counted if it was requested too.
+// Collectibles missing from ref, e.g. because it's older than the
+// character, aren't counted, so no category exceeds 100%.
+func CollectionCompletion(c *Character, ref *CollectionReference) (CollectionProgress, error) {
+	missing := make([]string, 0)
+	if c.Mounts == nil {
+		missing = append(missing, "mounts")
+	}
+	if c.Pets == nil {
+		missing = append(missing, "pets")
+	}
+	if len(missing) > 0 {
+		return CollectionProgress{}, errors.New(fmt.Sprintf("Character %s has no %s; request the field(s) to compute collection completion", c.Name, strings.Join(missing, " or ")))
+	}
+
+	progress := CollectionProgress{Titles: len(c.Titles)}
+	progress.Mounts.Total = len(ref.Mounts)
+	for _, mount := range c.Mounts.Collected {
+		if ref.Mounts[mount.SpellId] != nil {
+			progress.Mounts.Collected++
+		}
+	}
+	// Pets can be collected more than once.
+	pets := make(map[int]bool)
+	for _, pet := range c.Pets.Collected {
+		if ref.Pets[pet.CreatureId] != nil {
+			pets[pet.CreatureId] = true
+		}
+	}
+	progress.Pets = CategoryProgress{Collected: len(pets), Total: len(ref.Pets)}
+	progress.Overall = CategoryProgress{
+		Collected: progress.Mounts.Collected + progress.Pets.Collected,
+		Total:     progress.Mounts.Total + progress.Pets.Total,
+	}
+	return progress, nil
+}
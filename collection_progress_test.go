@@ -0,0 +1,38 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type CollectionProgressSuite struct{}
+
+var _ = Suite(&CollectionProgressSuite{})
+
+func (s *CollectionProgressSuite) Test_CollectionCompletion(c *C) {
+	ref := &CollectionReference{
+		Mounts: map[int]*Mount{24242: &Mount{SpellId: 24242}, 40192: &Mount{SpellId: 40192}, 61294: &Mount{SpellId: 61294}, 88744: &Mount{SpellId: 88744}},
+		Pets:   map[int]*CompanionPet{85009: &CompanionPet{CreatureId: 85009}, 68820: &CompanionPet{CreatureId: 68820}},
+	}
+	char := &Character{
+		Name:   "Capoferro",
+		Mounts: &MountList{Collected: []*Mount{&Mount{SpellId: 40192}, &Mount{SpellId: 99999}}},
+		Pets:   &PetList{Collected: []*Pet{&Pet{CreatureId: 85009}, &Pet{CreatureId: 85009}}},
+		Titles: []*Title{&Title{Id: 1}, &Title{Id: 2}},
+	}
+
+	progress, err := CollectionCompletion(char, ref)
+	c.Assert(err, IsNil)
+	c.Assert(progress.Mounts, Equals, CategoryProgress{Collected: 1, Total: 4})
+	c.Assert(progress.Mounts.Percent(), Equals, 25.0)
+	c.Assert(progress.Pets, Equals, CategoryProgress{Collected: 1, Total: 2})
+	c.Assert(progress.Titles, Equals, 2)
+	c.Assert(progress.Overall, Equals, CategoryProgress{Collected: 2, Total: 6})
+	c.Assert(CategoryProgress{}.Percent(), Equals, 0.0)
+
+	char.Pets = nil
+	_, err = CollectionCompletion(char, ref)
+	c.Assert(err, ErrorMatches, "Character Capoferro has no pets; .*")
+	char.Mounts = nil
+	_, err = CollectionCompletion(char, ref)
+	c.Assert(err, ErrorMatches, "Character Capoferro has no mounts or pets; .*")
+}
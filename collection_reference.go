@@ -0,0 +1,79 @@
+package wow
+
+import (
+	"context"
+)
+
+// CollectionReference is the full set of collectible mounts and pets,
+// for showing how much of each a character has collected. The API has
+// no master list of titles, so titles aren't included.
+type CollectionReference struct {
+	NumMounts int
+	NumPets   int
+	// Mounts is keyed by spell id, Pets by creature id.
+	Mounts map[int]*Mount
+	Pets   map[int]*CompanionPet
+}
+
+// PreloadCollections fetches the mount and pet master lists. Both are
+// cached, so later calls (and GetMounts and GetPets) don't make
+// requests.
+func (a *ApiClient) PreloadCollections(ctx context.Context) (*CollectionReference, error) {
+	client := a.WithContext(ctx)
+	mounts, err := client.GetMounts()
+	if err != nil {
+		return nil, err
+	}
+	pets, err := client.GetPets()
+	if err != nil {
+		return nil, err
+	}
+
+	reference := &CollectionReference{
+		Mounts: make(map[int]*Mount),
+		Pets:   make(map[int]*CompanionPet),
+	}
+	for _, mount := range mounts {
+		reference.Mounts[mount.SpellId] = mount
+	}
+	for _, pet := range pets {
+		reference.Pets[pet.CreatureId] = pet
+	}
+	reference.NumMounts = len(reference.Mounts)
+	reference.NumPets = len(reference.Pets)
+	return reference, nil
+}
+
+// MissingMounts returns the mounts that aren't in collected.
+func (r *CollectionReference) MissingMounts(collected *MountList) []*Mount {
+	owned := make(map[int]bool)
+	if collected != nil {
+		for _, mount := range collected.Collected {
+			owned[mount.SpellId] = true
+		}
+	}
+	missing := make([]*Mount, 0)
+	for spellId, mount := range r.Mounts {
+		if !owned[spellId] {
+			missing = append(missing, mount)
+		}
+	}
+	return missing
+}
+
+// MissingPets returns the pets that aren't in collected.
+func (r *CollectionReference) MissingPets(collected *PetList) []*CompanionPet {
+	owned := make(map[int]bool)
+	if collected != nil {
+		for _, pet := range collected.Collected {
+			owned[pet.CreatureId] = true
+		}
+	}
+	missing := make([]*CompanionPet, 0)
+	for creatureId, pet := range r.Pets {
+		if !owned[creatureId] {
+			missing = append(missing, pet)
+		}
+	}
+	return missing
+}
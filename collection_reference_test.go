@@ -0,0 +1,46 @@
+package wow
+
+import (
+	"context"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type CollectionReferenceSuite struct{}
+
+var _ = Suite(&CollectionReferenceSuite{})
+
+func (s *CollectionReferenceSuite) Test_PreloadCollections(c *C) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/wow/mount/":
+			w.Write([]byte(`{"mounts": [{"name": "Swift Razzashi Raptor", "spellId": 24242, "creatureId": 15289, "itemId": 19872, "qualityId": 4}, {"name": "Ashes of Al'ar", "spellId": 40192, "creatureId": 18545, "itemId": 32458, "qualityId": 4, "isFlying": true}]}`))
+		case "/wow/pet/":
+			w.Write([]byte(`{"pets": [{"name": "Murkalot", "creatureId": 85009}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	reference, err := client.PreloadCollections(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(reference.NumMounts, Equals, 2)
+	c.Assert(reference.NumPets, Equals, 1)
+	c.Assert(reference.Mounts[40192].IsFlying, Equals, true)
+	c.Assert(reference.Pets[85009].Name, Equals, "Murkalot")
+
+	_, err = client.PreloadCollections(context.Background())
+	c.Assert(err, IsNil)
+	c.Assert(requests, Equals, 2)
+
+	missing := reference.MissingMounts(&MountList{Collected: []*Mount{&Mount{SpellId: 40192}}})
+	c.Assert(len(missing), Equals, 1)
+	c.Assert(missing[0].Name, Equals, "Swift Razzashi Raptor")
+	c.Assert(len(reference.MissingPets(&PetList{Collected: []*Pet{&Pet{CreatureId: 85009}}})), Equals, 0)
+	c.Assert(len(reference.MissingPets(nil)), Equals, 1)
+}
@@ -0,0 +1,20 @@
+package wow
+
+// CompanionPet is an entry of the pet master list, describing a pet
+// species independently of any character's collection.
+type CompanionPet struct {
+	CanBattle     bool
+	CreatureId    int
+	Name          string
+	Family        string
+	Icon          string
+	QualityId     int
+	Stats         *PetStats
+	StrongAgainst []string
+	TypeId        int
+	WeakAgainst   []string
+}
+
+func (c *CompanionPet) GetName() string {
+	return c.Name
+}
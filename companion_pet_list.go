@@ -0,0 +1,5 @@
+package wow
+
+type companionPetList struct {
+	Pets []*CompanionPet
+}
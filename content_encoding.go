@@ -0,0 +1,51 @@
+package wow
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// decompressedBody returns body decompressed if the response has a
+// gzip Content-Encoding. http.Transport only decompresses responses
+// itself when it asked for gzip, so responses to requests with an
+// Accept-Encoding set elsewhere (e.g. by a Doer or proxy) arrive
+// compressed. Closing the returned body closes body.
+func decompressedBody(response *http.Response, body io.ReadCloser) (io.ReadCloser, error) {
+	if !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return body, nil
+	}
+	// Bodiless responses, such as a 304 or many errors, may still be
+	// tagged gzip.
+	buffered := bufio.NewReader(body)
+	if _, err := buffered.Peek(1); err == io.EOF {
+		return body, nil
+	}
+	reader, err := gzip.NewReader(buffered)
+	if err != nil {
+		return nil, err
+	}
+	return &gzipBody{Reader: reader, body: body}, nil
+}
+
+// readBody reads the response's body, decompressed.
+func readBody(response *http.Response) ([]byte, error) {
+	reader, err := decompressedBody(response, response.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(reader)
+}
+
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
@@ -0,0 +1,100 @@
+package wow
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type ContentEncodingSuite struct{}
+
+var _ = Suite(&ContentEncodingSuite{})
+
+func gzipped(s string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte(s))
+	w.Close()
+	return buf.Bytes()
+}
+
+func (s *ContentEncodingSuite) Test_gzip(c *C) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		switch r.URL.Path {
+		case "/wow/quest/13146":
+			w.Write(gzipped(`{"id": 13146, "title": "Generosity"}`))
+		case "/auctions.json":
+			w.Write(gzipped(auctionDumpJson))
+		default:
+			w.Write(gzipped(fmt.Sprintf(`{"files": [{"url": "%s/auctions.json", "lastModified": 1400000000000}]}`, server.URL)))
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	quest, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(quest.Title, Equals, "Generosity")
+
+	auctions, err := client.GetAuctions("runetotem")
+	c.Assert(err, IsNil)
+	c.Assert(len(auctions.Auctions), Equals, 3)
+}
+
+func (s *ContentEncodingSuite) Test_gzip_emptyBody(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		switch {
+		case r.URL.Path == "/wow/quest/500":
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.Header.Get("If-None-Match") == `"v1"`:
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("ETag", `"v1"`)
+			w.Write(gzipped(`{"id": 13146, "title": "Generosity"}`))
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.MaxRetries = 0
+	client.ETagCache = NewMemoryETagCache()
+
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	quest, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(quest.Title, Equals, "Generosity")
+
+	_, err = client.GetQuest(500)
+	var apiErr *ApiError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusInternalServerError)
+}
+
+func (s *ContentEncodingSuite) Test_gzip_maxAuctionBytes(c *C) {
+	auctions := strings.Repeat(`{"auc": 1, "item": 72092, "buyout": 10000, "quantity": 20},`, 2000)
+	dump := `{"auctions": [` + strings.TrimSuffix(auctions, ",") + `]}`
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auctions.json" {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write(gzipped(dump))
+			return
+		}
+		fmt.Fprintf(w, `{"files": [{"url": "%s/auctions.json", "lastModified": 1400000000000}]}`, server.URL)
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.MaxAuctionBytes = 10000
+	c.Assert(len(gzipped(dump)) < 10000, Equals, true)
+
+	_, err := client.GetAuctions("runetotem")
+	c.Assert(err, Equals, ErrAuctionTooLarge)
+}
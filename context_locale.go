@@ -0,0 +1,37 @@
+package wow
+
+import (
+	"context"
+)
+
+type contextLocaleKey struct{}
+
+// WithContextLocale returns a copy of ctx carrying locale. Requests
+// made by a client from WithContext(ctx) use that locale instead of
+// the client's, as long as it's valid for the client's region.
+func WithContextLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, contextLocaleKey{}, locale)
+}
+
+// locale returns the locale of the client's context if it's set and
+// valid, and the client's locale otherwise.
+func (a *ApiClient) locale() string {
+	if locale, ok := a.Context().Value(contextLocaleKey{}).(string); ok && locale != "" {
+		if a.validateLocale(locale) == nil {
+			return locale
+		}
+	}
+	return a.Locale
+}
+
+// WithLocale returns a copy of the client whose requests use locale,
+// which must be valid for the client's region, leaving the client's
+// own locale unchanged. Like WithContext's, the copy shares its cache
+// and other state with a.
+func (a *ApiClient) WithLocale(locale string) (*ApiClient, error) {
+	err := a.validateLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	return a.WithContext(WithContextLocale(a.Context(), locale)), nil
+}
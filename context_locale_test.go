@@ -0,0 +1,54 @@
+package wow
+
+import (
+	"context"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type ContextLocaleSuite struct{}
+
+var _ = Suite(&ContextLocaleSuite{})
+
+func (s *ContextLocaleSuite) Test_WithContextLocale(c *C) {
+	locales := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locales = append(locales, r.URL.Query().Get("locale"))
+		w.Write([]byte(`{"id": 13146, "races": []}`))
+	}))
+	defer server.Close()
+	client := testRegionClient(server, "EU", "")
+
+	_, err := client.WithContext(WithContextLocale(context.Background(), "fr_FR")).GetQuest(13146)
+	c.Assert(err, IsNil)
+	_, err = client.WithContext(WithContextLocale(context.Background(), "en_US")).GetQuest(13146)
+	c.Assert(err, IsNil)
+	_, err = client.WithContext(WithContextLocale(context.Background(), "de_DE")).GetRaces()
+	c.Assert(err, IsNil)
+	_, err = client.GetRaces()
+	c.Assert(err, IsNil)
+	c.Assert(locales, DeepEquals, []string{"fr_FR", "en_GB", "de_DE", "en_GB"})
+}
+
+func (s *ContextLocaleSuite) Test_WithLocale(c *C) {
+	locales := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		locales = append(locales, r.URL.Query().Get("locale"))
+		w.Write([]byte(`{"id": 2144, "points": 50}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	_, err := client.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	spanish, err := client.WithLocale("es_MX")
+	c.Assert(err, IsNil)
+	_, err = spanish.GetAchievement(2144)
+	c.Assert(err, IsNil)
+	c.Assert(locales, DeepEquals, []string{"en_US", "es_MX"})
+	c.Assert(client.Locale, Equals, "en_US")
+
+	_, err = client.WithLocale("fr_FR")
+	c.Assert(err.Error(), Equals, "Locale 'fr_FR' is not valid for region 'US'")
+}
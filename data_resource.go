@@ -0,0 +1,50 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DataResourceInfo describes one of the data resources, the static
+// lists under data/.
+type DataResourceInfo struct {
+	Name string
+	Path string
+	// Method is the ApiClient method that fetches the resource, and
+	// Type what it returns.
+	Method string
+	Type   string
+}
+
+var dataResources = []DataResourceInfo{
+	DataResourceInfo{"battlegroups", "data/battlegroups/", "GetBattlegroups", "[]*Battlegroup"},
+	DataResourceInfo{"races", "data/character/races", "GetRaces", "[]*Race"},
+	DataResourceInfo{"classes", "data/character/classes", "GetClasses", "[]*Class"},
+	DataResourceInfo{"achievements", "data/character/achievements", "GetAchievements", "[]*Achievement"},
+	DataResourceInfo{"guildRewards", "data/guild/rewards", "GetGuildRewards", "[]*GuildReward"},
+	DataResourceInfo{"guildPerks", "data/guild/perks", "GetGuildPerks", "[]*GuildPerk"},
+	DataResourceInfo{"guildAchievements", "data/guild/achievements", "GetGuildAchievements", "[]*Achievement"},
+	DataResourceInfo{"itemClasses", "data/item/classes", "GetItemClasses", "[]*ItemClass"},
+	DataResourceInfo{"talents", "data/talents", "GetTalents", "ClassTalentList"},
+	DataResourceInfo{"petTypes", "data/pet/types", "GetPetTypes", "[]*PetType"},
+}
+
+// DataResources lists the data resources the client supports.
+func DataResources() []DataResourceInfo {
+	resources := make([]DataResourceInfo, len(dataResources))
+	copy(resources, dataResources)
+	return resources
+}
+
+// FetchDataResource decodes the data resource with the given name (see
+// DataResources) into target. target receives the whole response, e.g.
+// {"races": [...]} for races, rather than what the resource's method
+// returns. Responses are cached.
+func (a *ApiClient) FetchDataResource(name string, target interface{}) error {
+	for _, resource := range dataResources {
+		if resource.Name == name {
+			return a.getCached(resource.Path, nil, target)
+		}
+	}
+	return errors.New(fmt.Sprintf("Data resource '%s' does not exist", name))
+}
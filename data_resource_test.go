@@ -0,0 +1,51 @@
+package wow
+
+import (
+	"io/ioutil"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+type DataResourceSuite struct{}
+
+var _ = Suite(&DataResourceSuite{})
+
+// Every data/ path the client requests must be listed, and every
+// listed method must exist and return the listed type.
+func (s *DataResourceSuite) Test_DataResources_inSync(c *C) {
+	listed := make(map[string]bool)
+	clientType := reflect.TypeOf(&ApiClient{})
+	for _, resource := range DataResources() {
+		listed[resource.Path] = true
+		method, ok := clientType.MethodByName(resource.Method)
+		c.Assert(ok, Equals, true)
+		c.Assert(strings.Replace(method.Type.Out(0).String(), "wow.", "", 1), Equals, resource.Type)
+	}
+
+	source, err := ioutil.ReadFile("api_client.go")
+	c.Assert(err, IsNil)
+	for _, path := range regexp.MustCompile(`"(data/[^"]*)"`).FindAllStringSubmatch(string(source), -1) {
+		c.Check(listed[path[1]], Equals, true)
+	}
+}
+
+func (s *DataResourceSuite) Test_FetchDataResource(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/data/pet/types")
+		w.Write([]byte(`{"petTypes": [{"id": 0, "key": "humanoid", "name": "Humanoid"}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	petTypes := &petTypeList{}
+	err := client.FetchDataResource("petTypes", petTypes)
+	c.Assert(err, IsNil)
+	c.Assert(petTypes.PetTypes[0].Key, Equals, "humanoid")
+
+	err = client.FetchDataResource("mounts", petTypes)
+	c.Assert(err.Error(), Equals, "Data resource 'mounts' does not exist")
+}
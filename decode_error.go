@@ -0,0 +1,132 @@
+package wow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DecodeError is returned when a response can't be decoded. Path
+// locates the offending value in the response, e.g.
+// "items.head.itemLevel" or "feed[3].timestamp", to help track down
+// changes to the API's schema.
+type DecodeError struct {
+	Path string
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("Could not decode response at '%s': %s", e.Path, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decodeJson is json.Unmarshal, but returns a *DecodeError for type
+// mismatches and malformed documents.
+func decodeJson(jsonBlob []byte, v interface{}) error {
+	err := json.Unmarshal(jsonBlob, v)
+	switch e := err.(type) {
+	case *json.UnmarshalTypeError:
+		return &DecodeError{Path: jsonPathAt(jsonBlob, e.Offset), Err: err}
+	case *json.SyntaxError:
+		return &DecodeError{Path: jsonPathAt(jsonBlob, e.Offset), Err: err}
+	}
+	return err
+}
+
+// isIncompleteJson reports whether jsonBlob is empty or cut off before
+// the end of its document, as opposed to malformed.
+func isIncompleteJson(jsonBlob []byte) bool {
+	var raw json.RawMessage
+	err := json.NewDecoder(bytes.NewReader(jsonBlob)).Decode(&raw)
+	return err == io.EOF || err == io.ErrUnexpectedEOF
+}
+
+type jsonPathFrame struct {
+	array     bool
+	index     int
+	key       string
+	expectKey bool
+}
+
+// jsonPathAt returns the path of the value that ends at offset in
+// jsonBlob, or the path reached when the document turns out to be
+// malformed before it.
+func jsonPathAt(jsonBlob []byte, offset int64) string {
+	decoder := json.NewDecoder(bytes.NewReader(jsonBlob))
+	decoder.UseNumber()
+	stack := make([]*jsonPathFrame, 0)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return formatJsonPath(stack)
+		}
+		var top *jsonPathFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		if top != nil && top.array && token != json.Delim(']') {
+			top.index++
+		}
+		if top != nil && !top.array && top.expectKey {
+			if key, ok := token.(string); ok {
+				top.key = key
+				top.expectKey = false
+				continue
+			}
+		}
+
+		switch token {
+		case json.Delim('{'):
+			stack = append(stack, &jsonPathFrame{expectKey: true})
+		case json.Delim('['):
+			stack = append(stack, &jsonPathFrame{array: true, index: -1})
+		case json.Delim('}'), json.Delim(']'):
+			if decoder.InputOffset() >= offset {
+				return formatJsonPath(stack)
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 && !stack[len(stack)-1].array {
+				stack[len(stack)-1].expectKey = true
+			}
+			continue
+		default:
+			if decoder.InputOffset() >= offset {
+				return formatJsonPath(stack)
+			}
+			if top != nil && !top.array {
+				top.expectKey = true
+			}
+			continue
+		}
+		if decoder.InputOffset() >= offset {
+			return formatJsonPath(stack[:len(stack)-1])
+		}
+	}
+}
+
+func formatJsonPath(stack []*jsonPathFrame) string {
+	var path strings.Builder
+	for _, frame := range stack {
+		if frame.array {
+			if frame.index >= 0 {
+				fmt.Fprintf(&path, "[%d]", frame.index)
+			}
+		} else if frame.key != "" && !frame.expectKey {
+			if path.Len() > 0 {
+				path.WriteString(".")
+			}
+			path.WriteString(frame.key)
+		}
+	}
+	if path.Len() == 0 {
+		return "(root)"
+	}
+	return path.String()
+}
@@ -0,0 +1,40 @@
+package wow
+
+import (
+	"errors"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type DecodeErrorSuite struct{}
+
+var _ = Suite(&DecodeErrorSuite{})
+
+func decodeErrorPath(c *C, jsonBlob string, v interface{}) string {
+	err := decodeJson([]byte(jsonBlob), v)
+	var decodeErr *DecodeError
+	c.Assert(errors.As(err, &decodeErr), Equals, true)
+	return decodeErr.Path
+}
+
+func (s *DecodeErrorSuite) Test_decodeJson_paths(c *C) {
+	c.Assert(decodeErrorPath(c, `{"name": "Capoferro", "items": {"averageItemLevel": 500, "head": {"id": 1, "itemLevel": "high"}}}`, &Character{}), Equals, "items.head.itemLevel")
+	c.Assert(decodeErrorPath(c, `{"feed": [{"type": "LOOT"}, {"type": "LOOT", "itemId": "x"}]}`, &Character{}), Equals, "feed[1].itemId")
+	c.Assert(decodeErrorPath(c, `{"level": "ninety"}`, &Character{}), Equals, "level")
+	c.Assert(decodeErrorPath(c, `{"files": [{"url": "a"}, {"url": 5}]}`, &AuctionData{}), Equals, "files[1].url")
+	c.Assert(decodeErrorPath(c, `[1]`, &Character{}), Equals, "(root)")
+	c.Assert(decodeErrorPath(c, `{"name": "Capoferro", "items": {"head": {"id": 1,}}}`, &Character{}), Equals, "items.head")
+	c.Assert(decodeJson([]byte(`{"name": "Capoferro"}`), &Character{}), IsNil)
+}
+
+func (s *DecodeErrorSuite) Test_GetCharacter_decodeError(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "Capoferro", "stats": {"health": "lots"}}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	_, err := client.GetCharacterWithFields("Runetotem", "Capoferro", []string{"stats"})
+	c.Assert(err, ErrorMatches, "Could not decode response at 'stats.health': .*")
+}
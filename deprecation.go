@@ -0,0 +1,54 @@
+package wow
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Deprecation records the Deprecation and Sunset headers the API
+// returned for a path. Either may be empty.
+type Deprecation struct {
+	Path        string
+	Deprecation string
+	Sunset      string
+}
+
+// Deprecations returns the deprecations seen so far, one per path.
+// Nothing is recorded unless TrackDeprecations is set.
+func (a *ApiClient) Deprecations() []*Deprecation {
+	state := a.state()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	deprecations := make([]*Deprecation, 0, len(state.deprecations))
+	for _, d := range state.deprecations {
+		deprecations = append(deprecations, d)
+	}
+	return deprecations
+}
+
+// checkDeprecation reports a response's Deprecation and Sunset headers
+// to the Observer and, if enabled, records them. They never cause a
+// request to fail.
+func (a *ApiClient) checkDeprecation(path string, header http.Header) {
+	d := &Deprecation{
+		Path:        path,
+		Deprecation: header.Get("Deprecation"),
+		Sunset:      header.Get("Sunset"),
+	}
+	if d.Deprecation == "" && d.Sunset == "" {
+		return
+	}
+
+	a.notify(&Event{
+		Type:    DeprecationEvent,
+		Path:    path,
+		Message: fmt.Sprintf("Deprecation: %q, Sunset: %q", d.Deprecation, d.Sunset),
+	})
+
+	if a.TrackDeprecations {
+		state := a.state()
+		state.mutex.Lock()
+		defer state.mutex.Unlock()
+		state.deprecations[path] = d
+	}
+}
@@ -0,0 +1,39 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type DeprecationSuite struct{}
+
+var _ = Suite(&DeprecationSuite{})
+
+func (s *DeprecationSuite) Test_Deprecations(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/quest/1") {
+			w.Header().Set("Sunset", "Sat, 01 Nov 2014 00:00:00 GMT")
+		}
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	events := make([]*Event, 0)
+	client.Observer = ObserverFunc(func(e *Event) { events = append(events, e) })
+
+	client.GetQuest(2)
+	client.GetQuest(1)
+	c.Assert(len(events), Equals, 1)
+	c.Assert(events[0].Type, Equals, DeprecationEvent)
+	c.Assert(events[0].Path, Equals, "quest/1")
+	c.Assert(len(client.Deprecations()), Equals, 0)
+
+	client.TrackDeprecations = true
+	client.GetQuest(1)
+	deprecations := client.Deprecations()
+	c.Assert(len(deprecations), Equals, 1)
+	c.Assert(deprecations[0].Sunset, Equals, "Sat, 01 Nov 2014 00:00:00 GMT")
+	c.Assert(deprecations[0].Deprecation, Equals, "")
+}
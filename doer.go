@@ -0,0 +1,76 @@
+package wow
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Doer sends HTTP requests. *http.Client is a Doer; wrap one to add
+// behaviour such as tracing to every request the client makes.
+// Requests carry the context given to WithContext, so a wrapper can
+// read request-scoped values like a trace span with req.Context().
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DefaultTimeout bounds how long the http.Client made by NewApiClient
+// waits for an API request, including reading its body. It doesn't
+// apply to auction file downloads, which can take much longer; see
+// DefaultDownloadTimeout.
+const DefaultTimeout = 60 * time.Second
+
+// defaultHttpClient sends the requests of clients without an
+// HttpClient, so they still share connections.
+var defaultHttpClient = &http.Client{Timeout: DefaultTimeout}
+
+// AuctionFilesEndpoint is the path given to ApiClient.DoerFor when
+// downloading auction files, which are served from a CDN rather than
+// the API and can be tens of megabytes.
+const AuctionFilesEndpoint = "auction/files"
+
+// WithContext returns a copy of the client whose requests use ctx.
+// Cancelling ctx aborts the copy's in-flight requests. The copy shares
+// its cache and other state with a, but later changes to a's fields
+// aren't reflected in it.
+func (a *ApiClient) WithContext(ctx context.Context) *ApiClient {
+	if ctx == nil {
+		panic("nil context")
+	}
+	a.state()
+	client := *a
+	client.ctx = ctx
+	return &client
+}
+
+// Context returns the client's context, which defaults to
+// context.Background().
+func (a *ApiClient) Context() context.Context {
+	if a.ctx == nil {
+		return context.Background()
+	}
+	return a.ctx
+}
+
+// contextError returns the context's error in place of err if the
+// client's context is done, since that's why the request failed.
+func (a *ApiClient) contextError(err error) error {
+	if ctxErr := a.Context().Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+// doerFor returns the Doer for requests to the API path, or
+// AuctionFilesEndpoint for auction file downloads.
+func (a *ApiClient) doerFor(path string) Doer {
+	if a.DoerFor != nil {
+		if doer := a.DoerFor(path); doer != nil {
+			return doer
+		}
+	}
+	if a.HttpClient == nil {
+		return defaultHttpClient
+	}
+	return a.HttpClient
+}
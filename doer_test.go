@@ -0,0 +1,145 @@
+package wow
+
+import (
+	"context"
+	. "launchpad.net/gocheck"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+type DoerSuite struct{}
+
+var _ = Suite(&DoerSuite{})
+
+type traceIdKey struct{}
+
+// tracingDoer shows how to wire in tracing without the client knowing
+// about it: values put in the context given to WithContext reach the
+// Doer through req.Context().
+type tracingDoer struct {
+	next Doer
+}
+
+func (d *tracingDoer) Do(req *http.Request) (*http.Response, error) {
+	if traceId, ok := req.Context().Value(traceIdKey{}).(string); ok {
+		req.Header.Set("X-Trace-Id", traceId)
+	}
+	return d.next.Do(req)
+}
+
+func (s *DoerSuite) Test_WithContext_tracing(c *C) {
+	traceIds := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceIds = append(traceIds, r.Header.Get("X-Trace-Id"))
+		w.Write([]byte(`{"id": 13146}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.HttpClient = &tracingDoer{next: http.DefaultClient}
+
+	ctx := context.WithValue(context.Background(), traceIdKey{}, "abc123")
+	_, err := client.WithContext(ctx).GetQuest(13146)
+	c.Assert(err, IsNil)
+	_, err = client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(traceIds, DeepEquals, []string{"abc123", ""})
+}
+
+func (s *DoerSuite) Test_WithContext_sharesState(c *C) {
+	client, _ := NewApiClient("US", "")
+	ctxClient := client.WithContext(context.Background())
+	ctxClient.state().cache.set("key", []byte("value"))
+	_, ok := client.state().cache.get("key")
+	c.Assert(ok, Equals, true)
+	c.Assert(client.Context(), Equals, context.Background())
+}
+
+type countingDoer struct {
+	next     Doer
+	requests int
+}
+
+func (d *countingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.requests++
+	return d.next.Do(req)
+}
+
+func (s *DoerSuite) Test_DoerFor(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"files": []}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	shared := &countingDoer{next: http.DefaultClient}
+	auctions := &countingDoer{next: http.DefaultClient}
+	client.HttpClient = shared
+	client.DoerFor = func(path string) Doer {
+		if strings.HasPrefix(path, "auction/") {
+			return auctions
+		}
+		return nil
+	}
+
+	client.GetAuctionData("runetotem")
+	client.GetQuest(13146)
+	c.Assert(auctions.requests, Equals, 1)
+	c.Assert(shared.requests, Equals, 1)
+}
+
+func (s *DoerSuite) Test_WithContext_cancelled(c *C) {
+	release := make(chan bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+	client := testClient(server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	_, err := client.WithContext(ctx).GetQuest(13146)
+	c.Assert(err, Equals, context.Canceled)
+	c.Assert(time.Since(start) < time.Second, Equals, true)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.WithContext(ctx).GetCharacter("Runetotem", "Capoferro")
+	c.Assert(err, Equals, context.DeadlineExceeded)
+}
+
+func (s *DoerSuite) Test_doerFor_reusesClient(c *C) {
+	client, _ := NewApiClient("US", "")
+	httpClient, ok := client.HttpClient.(*http.Client)
+	c.Assert(ok, Equals, true)
+	c.Assert(httpClient.Timeout, Equals, DefaultTimeout)
+	c.Assert(client.doerFor("quest/13146"), Equals, client.HttpClient)
+	c.Assert(client.doerFor("item/18803"), Equals, client.HttpClient)
+
+	bare := &ApiClient{}
+	c.Assert(bare.doerFor("quest/13146"), Equals, bare.doerFor("item/18803"))
+}
+
+func (s *DoerSuite) Test_keepAlive(c *C) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": 13146}`))
+	}))
+	connections := 0
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections++
+		}
+	}
+	server.Start()
+	defer server.Close()
+	client := testClient(server)
+
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	_, err = client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(connections, Equals, 1)
+}
@@ -0,0 +1,45 @@
+package wow
+
+import (
+	"sync"
+)
+
+// ETagCache stores API responses by request URL along with their
+// ETags, so they can be revalidated with If-None-Match rather than
+// downloaded again. Implementations must be safe for concurrent use;
+// NewMemoryETagCache's is, and others can be backed by e.g. Redis.
+type ETagCache interface {
+	// Get returns the ETag and body stored for url, if any.
+	Get(url string) (etag string, body []byte, ok bool)
+	// Set stores the ETag and body of a response for url.
+	Set(url string, etag string, body []byte)
+}
+
+// NewMemoryETagCache returns an ETagCache that keeps every response in
+// memory.
+func NewMemoryETagCache() ETagCache {
+	return &memoryETagCache{entries: make(map[string]etagEntry)}
+}
+
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+type memoryETagCache struct {
+	mutex   sync.Mutex
+	entries map[string]etagEntry
+}
+
+func (m *memoryETagCache) Get(url string) (string, []byte, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	entry, ok := m.entries[url]
+	return entry.etag, entry.body, ok
+}
+
+func (m *memoryETagCache) Set(url string, etag string, body []byte) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.entries[url] = etagEntry{etag: etag, body: body}
+}
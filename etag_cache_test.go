@@ -0,0 +1,75 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type ETagCacheSuite struct{}
+
+var _ = Suite(&ETagCacheSuite{})
+
+func (s *ETagCacheSuite) Test_ETagCache(c *C) {
+	requests, notModified := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"name": "Capoferro", "realm": "Runetotem", "level": 100, "achievementPoints": 12000}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.ETagCache = NewMemoryETagCache()
+
+	first, err := client.GetCharacter("Runetotem", "Capoferro")
+	c.Assert(err, IsNil)
+	second, err := client.GetCharacter("Runetotem", "Capoferro")
+	c.Assert(err, IsNil)
+	c.Assert(second, DeepEquals, first)
+	c.Assert(second.Name, Equals, "Capoferro")
+	c.Assert(second.Level, Equals, 100)
+	c.Assert(requests, Equals, 2)
+	c.Assert(notModified, Equals, 1)
+}
+
+func (s *ETagCacheSuite) Test_ETagCache_withoutCredentials(c *C) {
+	notModified := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"races": [{"id": 1, "name": "Human"}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.PublicKey = "old-key"
+	client.Secret = "secret"
+	cache := NewMemoryETagCache()
+	client.ETagCache = cache
+
+	_, err := client.GetRacesWithLocale("es_MX")
+	c.Assert(err, IsNil)
+	for key := range cache.(*memoryETagCache).entries {
+		c.Assert(strings.Contains(key, "apikey"), Equals, false)
+		c.Assert(strings.Contains(key, "key"), Equals, false)
+	}
+
+	// A rotated key still revalidates the cached response. A new client
+	// is used since races are also cached in memory.
+	other := testClient(server)
+	other.PublicKey = "new-key"
+	other.ETagCache = cache
+	races, err := other.GetRacesWithLocale("es_MX")
+	c.Assert(err, IsNil)
+	c.Assert(races[0].Name, Equals, "Human")
+	c.Assert(notModified, Equals, 1)
+}
@@ -9,4 +9,6 @@ type FeedEntry struct {
 	Quantity       int
 	Name           string
 	ItemId         int
+	// Character is only set on entries returned by GetGuildActivity.
+	Character string
 }
@@ -15,6 +15,17 @@ type Guild struct {
 	Challenge         []*Challenge
 }
 
+// Faction returns the name of the guild's faction, from Side.
+func (g *Guild) Faction() string {
+	switch g.Side {
+	case 0:
+		return "Alliance"
+	case 1:
+		return "Horde"
+	}
+	return "Unknown"
+}
+
 func (g *Guild) ItemNews() []*GuildNewsItem{
 	itemNews := make([]*GuildNewsItem, 0)
 	for _, n := range g.News {
@@ -0,0 +1,65 @@
+package wow
+
+import (
+	"time"
+)
+
+// GuildAchievementStatus is an achievement a guild has completed.
+// Achievement is only set once the status has been resolved with
+// ResolveGuildAchievementStatuses.
+type GuildAchievementStatus struct {
+	Id          int
+	Completed   time.Time
+	Achievement *Achievement
+}
+
+// GetGuildAchievementProgress returns the guild's completed
+// achievements, in the order the API lists them.
+func (a *ApiClient) GetGuildAchievementProgress(realm string, guildName string) ([]*GuildAchievementStatus, error) {
+	guild, err := a.GetGuildWithFields(realm, guildName, []string{"achievements"})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*GuildAchievementStatus, 0)
+	if guild.Achievements == nil {
+		return statuses, nil
+	}
+	timestamps := guild.Achievements.AchievementsCompletedTimestamp
+	for i, id := range guild.Achievements.AchievementsCompleted {
+		status := &GuildAchievementStatus{Id: id}
+		if i < len(timestamps) {
+			status.Completed = millisToTime(uint(timestamps[i]))
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// ResolveGuildAchievementStatuses sets the Achievement of each status,
+// giving its name and points, from the guild achievements data
+// resource, which is cached. Achievements missing from the resource
+// are left unresolved.
+func (a *ApiClient) ResolveGuildAchievementStatuses(statuses []*GuildAchievementStatus) error {
+	list := &guildAchievementList{}
+	err := a.getCached("data/guild/achievements", nil, list)
+	if err != nil {
+		return err
+	}
+	achievements := make(map[int]*Achievement)
+	indexAchievements(list.Achievements, achievements)
+	for _, status := range statuses {
+		status.Achievement = achievements[status.Id]
+	}
+	return nil
+}
+
+// indexAchievements adds the achievements of categories, at any depth,
+// to index by id.
+func indexAchievements(categories []*Achievement, index map[int]*Achievement) {
+	for _, category := range categories {
+		for _, achievement := range category.Achievements {
+			index[achievement.Id] = achievement
+		}
+		indexAchievements(category.Categories, index)
+	}
+}
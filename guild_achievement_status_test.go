@@ -0,0 +1,41 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+type GuildAchievementStatusSuite struct{}
+
+var _ = Suite(&GuildAchievementStatusSuite{})
+
+func (s *GuildAchievementStatusSuite) Test_GetGuildAchievementProgress(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/guild/runetotem/Phoenix":
+			c.Check(r.URL.Query().Get("fields"), Equals, "achievements")
+			w.Write([]byte(`{"name": "Phoenix", "achievements": {"achievementsCompleted": [4912, 5126], "achievementsCompletedTimestamp": [1400000000000, 1400000001000]}}`))
+		case "/wow/data/guild/achievements":
+			w.Write([]byte(`{"achievements": [{"id": 15088, "name": "General", "achievements": [{"id": 4912, "title": "Guild Level 25", "points": 10}], "categories": [{"id": 15089, "name": "Sub", "achievements": [{"id": 5126, "title": "Realm First! Level 25 Guild", "points": 0}]}]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	statuses, err := client.GetGuildAchievementProgress("runetotem", "Phoenix")
+	c.Assert(err, IsNil)
+	c.Assert(len(statuses), Equals, 2)
+	c.Assert(statuses[1].Id, Equals, 5126)
+	c.Assert(statuses[1].Completed.Equal(time.Unix(1400000001, 0)), Equals, true)
+	c.Assert(statuses[0].Achievement, IsNil)
+
+	err = client.ResolveGuildAchievementStatuses(statuses)
+	c.Assert(err, IsNil)
+	c.Assert(statuses[0].Achievement.Title, Equals, "Guild Level 25")
+	c.Assert(statuses[0].Achievement.Points, Equals, 10)
+	c.Assert(statuses[1].Achievement.Title, Equals, "Realm First! Level 25 Guild")
+}
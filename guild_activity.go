@@ -0,0 +1,106 @@
+package wow
+
+import (
+	"sort"
+	"sync"
+)
+
+// Number of member feeds GetGuildActivityWithOptions fetches at once
+// when GuildActivityOptions.Concurrency isn't set.
+const DefaultGuildActivityConcurrency = 4
+
+type GuildActivityOptions struct {
+	// MemberFeeds adds every member's character feed to the guild's
+	// news. This costs one request per member.
+	MemberFeeds bool
+	// Concurrency bounds how many member feeds are fetched at once.
+	Concurrency int
+}
+
+// GetGuildActivity returns the guild's news as feed entries, newest
+// first.
+func (a *ApiClient) GetGuildActivity(realm string, guildName string) ([]*FeedEntry, error) {
+	return a.GetGuildActivityWithOptions(realm, guildName, &GuildActivityOptions{})
+}
+
+// GetGuildActivityWithOptions returns the guild's news, and optionally
+// its members' feeds, merged into one stream of feed entries, newest
+// first. Members whose feed can't be fetched (e.g. hidden profiles)
+// are skipped, but if the client's context is done its error is
+// returned.
+func (a *ApiClient) GetGuildActivityWithOptions(realm string, guildName string, options *GuildActivityOptions) ([]*FeedEntry, error) {
+	if options == nil {
+		options = &GuildActivityOptions{}
+	}
+	fields := []string{"news"}
+	if options.MemberFeeds {
+		fields = append(fields, "members")
+	}
+	guild, err := a.GetGuildWithFields(realm, guildName, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*FeedEntry, 0, len(guild.News))
+	for _, news := range guild.News {
+		entries = append(entries, &FeedEntry{
+			Type:        news.Type,
+			Timestamp:   news.Timestamp,
+			Achievement: news.Achievement,
+			ItemId:      news.ItemId,
+			Character:   news.Character,
+		})
+	}
+
+	if options.MemberFeeds {
+		memberEntries, err := a.getMemberFeeds(guild.Members, options.Concurrency)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, memberEntries...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Timestamp > entries[j].Timestamp })
+	return entries, nil
+}
+
+func (a *ApiClient) getMemberFeeds(members []*GuildMember, concurrency int) ([]*FeedEntry, error) {
+	if concurrency < 1 {
+		concurrency = DefaultGuildActivityConcurrency
+	}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	entries := make([]*FeedEntry, 0)
+	slots := make(chan bool, concurrency)
+
+	for _, member := range members {
+		if member.Character == nil {
+			continue
+		}
+		if a.Context().Err() != nil {
+			break
+		}
+		slots <- true
+		wg.Add(1)
+		go func(member *SimpleCharacter) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			char, err := a.GetCharacterWithFields(member.Realm, member.Name, []string{"feed"})
+			if err != nil {
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, entry := range char.Feed {
+				entry.Character = member.Name
+				entries = append(entries, entry)
+			}
+		}(member.Character)
+	}
+	wg.Wait()
+
+	if err := a.Context().Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,57 @@
+package wow
+
+import (
+	"context"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type GuildActivitySuite struct{}
+
+var _ = Suite(&GuildActivitySuite{})
+
+func guildActivityServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/guild/Runetotem/Reforged":
+			w.Write([]byte(`{"name": "Reforged",
+				"news": [{"type": "itemLoot", "character": "Capoferro", "timestamp": 2000, "itemId": 104426}],
+				"members": [{"character": {"name": "Capoferro", "realm": "Runetotem"}}, {"character": {"name": "Hidden", "realm": "Runetotem"}}]}`))
+		case "/wow/character/Runetotem/Capoferro":
+			w.Write([]byte(`{"name": "Capoferro", "feed": [{"type": "ACHIEVEMENT", "timestamp": 3000}, {"type": "LOOT", "timestamp": 1000, "itemId": 1}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func (s *GuildActivitySuite) Test_GetGuildActivity(c *C) {
+	server := guildActivityServer()
+	defer server.Close()
+	client := testClient(server)
+
+	entries, err := client.GetGuildActivity("Runetotem", "Reforged")
+	c.Assert(err, IsNil)
+	c.Assert(len(entries), Equals, 1)
+	c.Assert(entries[0].Character, Equals, "Capoferro")
+
+	entries, err = client.GetGuildActivityWithOptions("Runetotem", "Reforged", &GuildActivityOptions{MemberFeeds: true, Concurrency: 2})
+	c.Assert(err, IsNil)
+	c.Assert(len(entries), Equals, 3)
+	c.Assert(entries[0].Type, Equals, "ACHIEVEMENT")
+	c.Assert(entries[1].Type, Equals, "itemLoot")
+	c.Assert(entries[2].Type, Equals, "LOOT")
+	c.Assert(entries[2].Character, Equals, "Capoferro")
+}
+
+func (s *GuildActivitySuite) Test_GetGuildActivity_cancelled(c *C) {
+	server := guildActivityServer()
+	defer server.Close()
+	client := testClient(server)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.WithContext(ctx).GetGuildActivityWithOptions("Runetotem", "Reforged", &GuildActivityOptions{MemberFeeds: true})
+	c.Assert(err, NotNil)
+}
@@ -1,5 +1,10 @@
 package wow
 
+import (
+	"errors"
+	"fmt"
+)
+
 type GuildMember struct {
 	Character *SimpleCharacter
 	Rank      int
@@ -11,3 +16,53 @@ type ByRank []*GuildMember
 func (a ByRank) Len() int           { return len(a) }
 func (a ByRank) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a ByRank) Less(i, j int) bool { return a[i].Rank < a[j].Rank }
+
+// ClassDistribution counts members per class id.
+func ClassDistribution(members []*GuildMember) map[int]int {
+	counts := make(map[int]int)
+	for _, m := range members {
+		if m.Character != nil {
+			counts[m.Character.Class]++
+		}
+	}
+	return counts
+}
+
+// LevelDistribution counts members per level range of the given size.
+// Ranges are keyed by their lowest level, so with a size of 10 a level
+// 85 member is counted under 80 and a level 90 member under 90.
+func LevelDistribution(members []*GuildMember, size int) map[int]int {
+	counts := make(map[int]int)
+	if size < 1 {
+		size = 1
+	}
+	for _, m := range members {
+		if m.Character != nil {
+			counts[m.Character.Level-m.Character.Level%size]++
+		}
+	}
+	return counts
+}
+
+// ClassNameDistribution counts members per class name, using the
+// client's locale for the names.
+func (a *ApiClient) ClassNameDistribution(members []*GuildMember) (map[string]int, error) {
+	classes, err := a.GetClasses()
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int]string)
+	for _, class := range classes {
+		names[class.Id] = class.Name
+	}
+
+	counts := make(map[string]int)
+	for id, count := range ClassDistribution(members) {
+		name, ok := names[id]
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("%d is not a valid class id", id))
+		}
+		counts[name] += count
+	}
+	return counts, nil
+}
@@ -0,0 +1,23 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type GuildMemberSuite struct{}
+
+var _ = Suite(&GuildMemberSuite{})
+
+func guildMember(class int, level int) *GuildMember {
+	return &GuildMember{Character: &SimpleCharacter{Class: class, Level: level}}
+}
+
+func (s *GuildMemberSuite) Test_ClassDistribution(c *C) {
+	members := []*GuildMember{guildMember(1, 90), guildMember(8, 85), guildMember(1, 12), &GuildMember{}}
+	c.Assert(ClassDistribution(members), DeepEquals, map[int]int{1: 2, 8: 1})
+}
+
+func (s *GuildMemberSuite) Test_LevelDistribution(c *C) {
+	members := []*GuildMember{guildMember(1, 90), guildMember(8, 85), guildMember(1, 89), guildMember(2, 5)}
+	c.Assert(LevelDistribution(members, 10), DeepEquals, map[int]int{90: 1, 80: 2, 0: 1})
+}
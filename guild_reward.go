@@ -7,3 +7,14 @@ type GuildReward struct {
 	Achievement      *Achievement
 	Item             *Item
 }
+
+// RacesMask returns Races as a race mask, the form Race.Mask and item
+// restrictions use. A reward without races is available to all of
+// them and has a mask of 0.
+func (r *GuildReward) RacesMask() int {
+	mask := 0
+	for _, id := range r.Races {
+		mask |= 1 << uint(id-1)
+	}
+	return mask
+}
@@ -0,0 +1,41 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type GuildSuite struct{}
+
+var _ = Suite(&GuildSuite{})
+
+func (s *GuildSuite) Test_GetGuildWithFields(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/guild/Runetotem/Knights of Azeroth")
+		c.Check(r.URL.Query().Get("fields"), Equals, "members,news")
+		w.Write([]byte(`{"lastModified": 1400000000000, "name": "Knights of Azeroth", "realm": "Runetotem", "battlegroup": "Vindication", "level": 25, "side": 1, "achievementPoints": 1500,
+			"emblem": {"icon": 126, "iconColor": "ffdfa55a", "border": 0, "borderColor": "ff0f1415", "backgroundColor": "ff232323"},
+			"members": [{"character": {"name": "Capoferro", "realm": "Runetotem", "level": 90}, "rank": 0}],
+			"news": [{"type": "itemLoot", "character": "Capoferro", "timestamp": 1400000000000, "itemId": 76749}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	guild, err := client.GetGuildWithFields("Runetotem", "Knights of Azeroth", []string{"news", "members"})
+	c.Assert(err, IsNil)
+	c.Assert(guild.Name, Equals, "Knights of Azeroth")
+	c.Assert(guild.Level, Equals, 25)
+	c.Assert(guild.Faction(), Equals, "Horde")
+	c.Assert(guild.Emblem.Icon, Equals, 126)
+	c.Assert(len(guild.Members), Equals, 1)
+	c.Assert(guild.Members[0].Character.Name, Equals, "Capoferro")
+	c.Assert(len(guild.ItemNews()), Equals, 1)
+	c.Assert(guild.Achievements, IsNil)
+}
+
+func (s *GuildSuite) Test_GetGuildWithFields_invalidFields(c *C) {
+	client, _ := NewApiClient("US", "")
+	_, err := client.GetGuildWithFields("Runetotem", "Knights of Azeroth", []string{"members", "items", "talents"})
+	c.Assert(err.Error(), Equals, "The following fields are not valid: [items talents]")
+}
@@ -0,0 +1,61 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Icon sizes, in pixels, that the media host serves.
+var IconSizes = []int{18, 36, 56}
+
+// Media hosts per region, serving icons and other art.
+var mediaHosts = map[string]string{
+	"US": "us.media.blizzard.com",
+	"EU": "eu.media.blizzard.com",
+	"KR": "kr.media.blizzard.com",
+	"TW": "tw.media.blizzard.com",
+	"ZH": "content.battlenet.com.cn",
+}
+
+// IconURL returns the URL of the icon (e.g. an Item's or Achievement's
+// Icon) at size pixels, on the client's regional media host.
+func (a *ApiClient) IconURL(icon string, size int) (string, error) {
+	err := validateIconSize(size)
+	if err != nil {
+		return "", err
+	}
+	return a.iconURL(icon, size), nil
+}
+
+// IconURLs returns the URLs of the icons at size pixels, keyed by icon
+// name. Empty names are skipped.
+func (a *ApiClient) IconURLs(icons []string, size int) (map[string]string, error) {
+	err := validateIconSize(size)
+	if err != nil {
+		return nil, err
+	}
+	urls := make(map[string]string)
+	for _, icon := range icons {
+		if icon != "" {
+			urls[icon] = a.iconURL(icon, size)
+		}
+	}
+	return urls, nil
+}
+
+func (a *ApiClient) iconURL(icon string, size int) string {
+	host, ok := mediaHosts[regionCode(a.region)]
+	if !ok {
+		host = mediaHosts["US"]
+	}
+	return fmt.Sprintf("https://%s/wow/icons/%d/%s.jpg", host, size, icon)
+}
+
+func validateIconSize(size int) error {
+	for _, valid := range IconSizes {
+		if size == valid {
+			return nil
+		}
+	}
+	return errors.New(fmt.Sprintf("Icon size %d is not valid, must be one of %v", size, IconSizes))
+}
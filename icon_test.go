@@ -0,0 +1,26 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type IconSuite struct{}
+
+var _ = Suite(&IconSuite{})
+
+func (s *IconSuite) Test_IconURLs(c *C) {
+	client, _ := NewApiClient("Europe", "")
+	urls, err := client.IconURLs([]string{"inv_gizmo_02", "", "achievement_level_85"}, 56)
+	c.Assert(err, IsNil)
+	c.Assert(urls, DeepEquals, map[string]string{
+		"inv_gizmo_02":         "https://eu.media.blizzard.com/wow/icons/56/inv_gizmo_02.jpg",
+		"achievement_level_85": "https://eu.media.blizzard.com/wow/icons/56/achievement_level_85.jpg",
+	})
+
+	_, err = client.IconURLs([]string{"inv_gizmo_02"}, 64)
+	c.Assert(err.Error(), Equals, "Icon size 64 is not valid, must be one of [18 36 56]")
+
+	url, err := (&ApiClient{}).IconURL("inv_gizmo_02", 18)
+	c.Assert(err, IsNil)
+	c.Assert(url, Equals, "https://us.media.blizzard.com/wow/icons/18/inv_gizmo_02.jpg")
+}
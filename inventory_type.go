@@ -0,0 +1,138 @@
+package wow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// InventoryType is the equipment slot an item occupies, as reported in
+// an item's inventoryType. Items that can't be equipped have
+// InventoryTypeNone.
+type InventoryType int
+
+const (
+	InventoryTypeNone InventoryType = iota
+	InventoryTypeHead
+	InventoryTypeNeck
+	InventoryTypeShoulder
+	InventoryTypeShirt
+	InventoryTypeChest
+	InventoryTypeWaist
+	InventoryTypeLegs
+	InventoryTypeFeet
+	InventoryTypeWrist
+	InventoryTypeHands
+	InventoryTypeFinger
+	InventoryTypeTrinket
+	InventoryTypeOneHand
+	InventoryTypeShield
+	InventoryTypeRanged
+	InventoryTypeBack
+	InventoryTypeTwoHand
+	InventoryTypeBag
+	InventoryTypeTabard
+	InventoryTypeRobe
+	InventoryTypeMainHand
+	InventoryTypeOffHand
+	InventoryTypeHeldInOffHand
+	InventoryTypeAmmo
+	InventoryTypeThrown
+	InventoryTypeRangedRight
+	InventoryTypeQuiver
+	InventoryTypeRelic
+)
+
+var inventoryTypeNames = []string{
+	"None",
+	"Head",
+	"Neck",
+	"Shoulder",
+	"Shirt",
+	"Chest",
+	"Waist",
+	"Legs",
+	"Feet",
+	"Wrist",
+	"Hands",
+	"Finger",
+	"Trinket",
+	"One-Hand",
+	"Shield",
+	"Ranged",
+	"Back",
+	"Two-Hand",
+	"Bag",
+	"Tabard",
+	"Robe",
+	"Main Hand",
+	"Off Hand",
+	"Held In Off-hand",
+	"Ammo",
+	"Thrown",
+	"Ranged Right",
+	"Quiver",
+	"Relic",
+}
+
+func (t InventoryType) String() string {
+	if t < 0 || int(t) >= len(inventoryTypeNames) {
+		return fmt.Sprintf("Unknown (%d)", int(t))
+	}
+	return inventoryTypeNames[t]
+}
+
+// IsEquippable reports whether items of this type can be equipped.
+func (t InventoryType) IsEquippable() bool {
+	return t != InventoryTypeNone
+}
+
+func (t *InventoryType) UnmarshalJSON(data []byte) error {
+	var id int
+	err := json.Unmarshal(data, &id)
+	if err != nil {
+		return errors.New(fmt.Sprintf("'%s' is not a valid inventory type", data))
+	}
+	*t = InventoryType(id)
+	return nil
+}
+
+// Slots returns the names of the ItemList slots an item of this type
+// can be equipped in, e.g. "finger1" and "finger2" for rings.
+func (t InventoryType) Slots() []string {
+	switch t {
+	case InventoryTypeHead:
+		return []string{"head"}
+	case InventoryTypeNeck:
+		return []string{"neck"}
+	case InventoryTypeShoulder:
+		return []string{"shoulder"}
+	case InventoryTypeShirt:
+		return []string{"shirt"}
+	case InventoryTypeChest, InventoryTypeRobe:
+		return []string{"chest"}
+	case InventoryTypeWaist:
+		return []string{"waist"}
+	case InventoryTypeLegs:
+		return []string{"legs"}
+	case InventoryTypeFeet:
+		return []string{"feet"}
+	case InventoryTypeWrist:
+		return []string{"wrist"}
+	case InventoryTypeHands:
+		return []string{"hands"}
+	case InventoryTypeFinger:
+		return []string{"finger1", "finger2"}
+	case InventoryTypeTrinket:
+		return []string{"trinket1", "trinket2"}
+	case InventoryTypeBack:
+		return []string{"back"}
+	case InventoryTypeOneHand:
+		return []string{"mainHand", "offHand"}
+	case InventoryTypeTwoHand, InventoryTypeMainHand, InventoryTypeRanged, InventoryTypeRangedRight:
+		return []string{"mainHand"}
+	case InventoryTypeShield, InventoryTypeOffHand, InventoryTypeHeldInOffHand:
+		return []string{"offHand"}
+	}
+	return []string{}
+}
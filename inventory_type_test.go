@@ -0,0 +1,27 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type InventoryTypeSuite struct{}
+
+var _ = Suite(&InventoryTypeSuite{})
+
+func (s *InventoryTypeSuite) Test_NewItemFromJson(c *C) {
+	item, err := NewItemFromJson([]byte(`{"id": 18803, "inventoryType": 17}`))
+	c.Assert(err, IsNil)
+	c.Assert(item.InventoryType, Equals, InventoryTypeTwoHand)
+	c.Assert(item.InventoryType.String(), Equals, "Two-Hand")
+}
+
+func (s *InventoryTypeSuite) Test_NewItemFromJson_none(c *C) {
+	item, err := NewItemFromJson([]byte(`{"id": 104426, "inventoryType": 0}`))
+	c.Assert(err, IsNil)
+	c.Assert(item.InventoryType, Equals, InventoryTypeNone)
+	c.Assert(item.InventoryType.IsEquippable(), Equals, false)
+}
+
+func (s *InventoryTypeSuite) Test_String_unknown(c *C) {
+	c.Assert(InventoryType(99).String(), Equals, "Unknown (99)")
+}
@@ -10,6 +10,7 @@ type Item struct {
 	Name                   string
 	Quality                int
 	TooltipParams          *TooltipParams
+	BonusLists             []int
 	BonusStats             []*Stat
 	Stats                  []*Stat
 	Armor                  int
@@ -23,13 +24,14 @@ type Item struct {
 	Equipable              bool
 	HasSockets             bool
 	HeroicTooltip          bool
-	InventoryType          int
+	InventoryType          InventoryType
 	IsAuctionable          bool
 	ItemBind               int
 	ItemClass              int
 	ItemLevel              int
+	ItemSet                *ItemSet
 	ItemSource             *ItemSource
-	ItemSpells             []*Spell
+	ItemSpells             []*ItemSpell
 	ItemSubclass           int
 	MaxCount               int
 	MaxDurability          int
@@ -41,8 +43,19 @@ type Item struct {
 	RequiredSkill          int
 	RequiredSkillRank      int
 	SellPrice              int
+	SocketInfo             *SocketInfo
 	Stackable              int
 	Upgradable             bool
+	// Ids of the classes and races that can use the item. Empty when
+	// anyone can.
+	AllowableClasses []int
+	AllowableRaces   []int
+	// Context is the context (e.g. "raid-heroic") the item was fetched
+	// for. Items that drop in several contexts list them in
+	// AvailableContexts; fetch one with GetItemWithContext to get its
+	// stats and bonuses.
+	Context           string
+	AvailableContexts []string
 }
 
 func NewItemFromJson(jsonBlob []byte) (*Item, error) {
@@ -61,3 +74,57 @@ func NewItemFromJson(jsonBlob []byte) (*Item, error) {
 
 	return item, nil
 }
+
+// IsEnchanted reports whether the item's tooltip params carry an
+// enchant.
+func (i *Item) IsEnchanted() bool {
+	return i.TooltipParams != nil && i.TooltipParams.Enchant != 0
+}
+
+// SocketCount returns the number of sockets the item is known to
+// have. Character items only list sockets added by an extra socket
+// (e.g. a belt buckle); the item's own sockets are only known once it
+// has been looked up with GetItem.
+func (i *Item) SocketCount() int {
+	count := 0
+	if i.SocketInfo != nil {
+		count += len(i.SocketInfo.Sockets)
+	}
+	if i.TooltipParams != nil && i.TooltipParams.ExtraSocket {
+		count++
+	}
+	return count
+}
+
+// GemCount returns the number of gems socketed into the item.
+func (i *Item) GemCount() int {
+	if i.TooltipParams == nil {
+		return 0
+	}
+	return len(i.TooltipParams.Gems())
+}
+
+func (i *Item) GetName() string {
+	return i.Name
+}
+
+// CanBeUsedBy reports whether a character of the given class, race and
+// level meets the item's requirements.
+func (i *Item) CanBeUsedBy(classId int, raceId int, level int) bool {
+	if level < i.RequiredLevel {
+		return false
+	}
+	return allows(i.AllowableClasses, classId) && allows(i.AllowableRaces, raceId)
+}
+
+func allows(allowed []int, id int) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if a == id {
+			return true
+		}
+	}
+	return false
+}
@@ -5,3 +5,17 @@ type ItemClass struct {
 	Name       string
 	Subclasses []*ItemSubclass
 }
+
+func (i *ItemClass) GetName() string {
+	return i.Name
+}
+
+// SubclassNames returns the names of the class's subclasses, keyed by
+// subclass id, e.g. to name an Item's ItemSubClass.
+func (i *ItemClass) SubclassNames() map[int]string {
+	names := make(map[int]string)
+	for _, subclass := range i.Subclasses {
+		names[subclass.Subclass] = subclass.Name
+	}
+	return names
+}
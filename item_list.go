@@ -1,5 +1,10 @@
 package wow
 
+import (
+	"errors"
+	"fmt"
+)
+
 type ItemList struct {
 	AverageItemLevel         int
 	AverageItemLevelEquipped int
@@ -21,3 +26,154 @@ type ItemList struct {
 	MainHand                 *Item
 	OffHand                  *Item
 }
+
+// Slots in the order the armory lists them.
+var itemSlots = []string{
+	"head",
+	"neck",
+	"shoulder",
+	"back",
+	"chest",
+	"shirt",
+	"wrist",
+	"hands",
+	"waist",
+	"legs",
+	"feet",
+	"finger1",
+	"finger2",
+	"trinket1",
+	"trinket2",
+	"mainHand",
+	"offHand",
+}
+
+// Slots that can be enchanted.
+var enchantableItemSlots = map[string]bool{
+	"shoulder": true,
+	"back":     true,
+	"chest":    true,
+	"wrist":    true,
+	"hands":    true,
+	"legs":     true,
+	"feet":     true,
+	"mainHand": true,
+	"offHand":  true,
+}
+
+// Slot returns the item equipped in the named slot, or nil if the slot
+// is empty or unknown. Slot names match the JSON keys of the items
+// field, e.g. "head" or "mainHand".
+func (l *ItemList) Slot(name string) *Item {
+	switch name {
+	case "head":
+		return l.Head
+	case "neck":
+		return l.Neck
+	case "shoulder":
+		return l.Shoulder
+	case "back":
+		return l.Back
+	case "chest":
+		return l.Chest
+	case "shirt":
+		return l.Shirt
+	case "wrist":
+		return l.Wrist
+	case "hands":
+		return l.Hands
+	case "waist":
+		return l.Waist
+	case "legs":
+		return l.Legs
+	case "feet":
+		return l.Feet
+	case "finger1":
+		return l.Finger1
+	case "finger2":
+		return l.Finger2
+	case "trinket1":
+		return l.Trinket1
+	case "trinket2":
+		return l.Trinket2
+	case "mainHand":
+		return l.MainHand
+	case "offHand":
+		return l.OffHand
+	}
+	return nil
+}
+
+// Slots returns the equipped items keyed by slot name. Empty slots are
+// left out.
+func (l *ItemList) Slots() map[string]*Item {
+	slots := make(map[string]*Item)
+	for _, name := range itemSlots {
+		if item := l.Slot(name); item != nil {
+			slots[name] = item
+		}
+	}
+	return slots
+}
+
+// CanBeEnchanted reports whether items in the named slot can be
+// enchanted.
+func CanBeEnchanted(slot string) bool {
+	return enchantableItemSlots[slot]
+}
+
+// MissingEnchants returns the names of the enchantable slots whose
+// equipped item has no enchant.
+func (l *ItemList) MissingEnchants() []string {
+	missing := make([]string, 0)
+	for _, name := range itemSlots {
+		item := l.Slot(name)
+		if item != nil && CanBeEnchanted(name) && !item.IsEnchanted() {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// EmptySockets returns the names of the slots whose equipped item has
+// more sockets than gems. See Item.SocketCount for which sockets are
+// known.
+func (l *ItemList) EmptySockets() []string {
+	empty := make([]string, 0)
+	for _, name := range itemSlots {
+		item := l.Slot(name)
+		if item != nil && item.GemCount() < item.SocketCount() {
+			empty = append(empty, name)
+		}
+	}
+	return empty
+}
+
+// ResolveEquippedItems looks up the full item document, with its bonus
+// lists applied, for every equipped item. Results are keyed by slot
+// name and empty slots are skipped. Lookups are cached and identical
+// items (e.g. two copies of a ring) are only fetched once. A failed
+// lookup doesn't stop the others; its error names the slot.
+func (a *ApiClient) ResolveEquippedItems(items *ItemList) (map[string]*Item, []error) {
+	resolved := make(map[string]*Item)
+	errs := make([]error, 0)
+	if items == nil {
+		return resolved, errs
+	}
+
+	for _, name := range itemSlots {
+		equipped := items.Slot(name)
+		if equipped == nil {
+			continue
+		}
+		jsonBlob, err := a.getCachedBlob(fmt.Sprintf("item/%d", equipped.Id), bonusListParams(equipped.BonusLists))
+		if err == nil {
+			resolved[name], err = NewItemFromJson(jsonBlob)
+		}
+		if err != nil {
+			delete(resolved, name)
+			errs = append(errs, errors.New(fmt.Sprintf("Could not resolve %s item %d: %s", name, equipped.Id, err)))
+		}
+	}
+	return resolved, errs
+}
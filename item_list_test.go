@@ -0,0 +1,104 @@
+package wow
+
+import (
+	"encoding/json"
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type ItemListSuite struct{}
+
+var _ = Suite(&ItemListSuite{})
+
+const itemListJson = `{
+	"averageItemLevel": 496,
+	"averageItemLevelEquipped": 493,
+	"head": {"id": 99542, "name": "Helm", "tooltipParams": {"gem0": 76884, "upgrade": {"current": 2, "total": 2, "itemLevelIncrement": 8}}},
+	"shoulder": {"id": 99544, "name": "Shoulderguards", "tooltipParams": {"enchant": 4803, "gem0": 76680}},
+	"chest": {"id": 99540, "name": "Breastplate", "tooltipParams": {}},
+	"waist": {"id": 98989, "name": "Girdle", "tooltipParams": {"gem0": 76680, "extraSocket": true}},
+	"legs": {"id": 99541, "name": "Legplates", "tooltipParams": {"enchant": 4824}},
+	"mainHand": {"id": 105673, "name": "Greataxe", "tooltipParams": {"enchant": 4444}}
+}`
+
+func (s *ItemListSuite) Test_Slots(c *C) {
+	l := &ItemList{}
+	c.Assert(json.Unmarshal([]byte(itemListJson), l), IsNil)
+	slots := l.Slots()
+	c.Assert(len(slots), Equals, 6)
+	c.Assert(slots["mainHand"].Id, Equals, 105673)
+	c.Assert(slots["neck"], IsNil)
+}
+
+func (s *ItemListSuite) Test_MissingEnchants(c *C) {
+	l := &ItemList{}
+	c.Assert(json.Unmarshal([]byte(itemListJson), l), IsNil)
+	c.Assert(l.Shoulder.IsEnchanted(), Equals, true)
+	c.Assert(l.Chest.IsEnchanted(), Equals, false)
+	c.Assert(l.Head.IsEnchanted(), Equals, false)
+	c.Assert(l.MissingEnchants(), DeepEquals, []string{"chest"})
+}
+
+func (s *ItemListSuite) Test_EmptySockets(c *C) {
+	l := &ItemList{}
+	c.Assert(json.Unmarshal([]byte(itemListJson), l), IsNil)
+	c.Assert(l.Waist.SocketCount(), Equals, 1)
+	c.Assert(l.Waist.GemCount(), Equals, 1)
+	c.Assert(l.EmptySockets(), DeepEquals, []string{})
+
+	l.Chest.SocketInfo = &SocketInfo{Sockets: []*Socket{&Socket{Type: "RED"}}}
+	c.Assert(l.EmptySockets(), DeepEquals, []string{"chest"})
+}
+
+func (s *ItemListSuite) Test_ResolveEquippedItems(c *C) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/wow/item/99540" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %s, "itemLevel": 1, "bonusStats": [{"stat": 7, "amount": 1}]}`, strings.TrimPrefix(r.URL.Path, "/wow/item/"))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.MaxRetries = 0
+
+	l := &ItemList{}
+	c.Assert(json.Unmarshal([]byte(itemListJson), l), IsNil)
+	l.Finger1 = &Item{Id: 7}
+	l.Finger2 = &Item{Id: 7}
+	items, errs := client.ResolveEquippedItems(l)
+	c.Assert(len(errs), Equals, 1)
+	c.Assert(len(items), Equals, 7)
+	c.Assert(items["chest"], IsNil)
+	c.Assert(items["finger2"].Id, Equals, 7)
+	c.Assert(len(items["head"].Stats), Equals, 1)
+	c.Assert(requests, Equals, 7)
+}
+
+func (s *ItemListSuite) Test_GetCharacterItems(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Query().Get("fields"), Equals, "items")
+		w.Write([]byte(`{"name": "Capoferro", "items": {"averageItemLevel": 496,
+			"back": {"id": 98149, "tooltipParams": {"enchant": 4892, "tinker": 4898, "transmogItem": 65000, "suffix": -39, "seed": 1381761664, "set": [99542, 99544], "upgrade": {"current": 1, "total": 2, "itemLevelIncrement": 4}, "newParam": 7}}}}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	l, err := client.GetCharacterItems("Runetotem", "Capoferro")
+	c.Assert(err, IsNil)
+	c.Assert(l.AverageItemLevel, Equals, 496)
+	t := l.Back.TooltipParams
+	c.Assert(t.Enchant, Equals, 4892)
+	c.Assert(t.Tinker, Equals, 4898)
+	c.Assert(t.TransmogItem, Equals, 65000)
+	c.Assert(t.Suffix, Equals, -39)
+	c.Assert(t.Seed, Equals, int64(1381761664))
+	c.Assert(t.Set, DeepEquals, []int{99542, 99544})
+	c.Assert(t.Upgrade.Current, Equals, 1)
+	c.Assert(string(t.Raw["newParam"]), Equals, "7")
+}
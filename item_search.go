@@ -0,0 +1,62 @@
+package wow
+
+import (
+	"sort"
+	"strings"
+)
+
+// The API can't search items by name: there's no /wow/search/item
+// endpoint, nor a resource listing every item. Names are resolved with
+// an ItemIndex instead, built from items whose ids are already known,
+// e.g. from auction data or character equipment.
+
+// ItemIndex finds items by their exact name or their id.
+type ItemIndex struct {
+	byName map[string][]*Item
+	byId   map[int]*Item
+}
+
+// NewItemIndex fetches the items with the given ids, running up to
+// concurrency requests at once (0 means DefaultBatchConcurrency), and
+// indexes them by their name in the client's locale. Items are cached,
+// so indexing them again doesn't make requests. Items that fail to load
+// are left out and their errors returned.
+func (a *ApiClient) NewItemIndex(ids []int, concurrency int) (*ItemIndex, []error) {
+	resolved, failed := a.resolveIds(ids, concurrency, "item/%d", func() interface{} { return &Item{} })
+	items := make([]*Item, 0, len(resolved))
+	for _, item := range resolved {
+		items = append(items, item.(*Item))
+	}
+	return newItemIndex(items), loadErrors("item", failed)
+}
+
+// newItemIndex indexes items, skipping nils.
+func newItemIndex(items []*Item) *ItemIndex {
+	index := &ItemIndex{byName: make(map[string][]*Item), byId: make(map[int]*Item)}
+	for _, item := range items {
+		if item != nil {
+			key := strings.ToLower(item.Name)
+			index.byName[key] = append(index.byName[key], item)
+			index.byId[item.Id] = item
+		}
+	}
+	for _, named := range index.byName {
+		sort.Slice(named, func(i, j int) bool {
+			return named[i].Id < named[j].Id
+		})
+	}
+	return index
+}
+
+// ItemsNamed returns the indexed items named name, ignoring case, in
+// order of id. Several items can share a name, e.g. versions of the
+// same gear from different difficulties.
+func (i *ItemIndex) ItemsNamed(name string) []*Item {
+	return i.byName[strings.ToLower(name)]
+}
+
+// Item returns the indexed item with the given id, or nil if it isn't
+// indexed.
+func (i *ItemIndex) Item(id int) *Item {
+	return i.byId[id]
+}
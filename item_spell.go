@@ -0,0 +1,11 @@
+package wow
+
+// ItemSpell is a spell an item casts, e.g. on use or on equip.
+type ItemSpell struct {
+	SpellId    int
+	Spell      *Spell
+	NCharges   int
+	Consumable bool
+	CategoryId int
+	Trigger    string
+}
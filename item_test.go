@@ -0,0 +1,95 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type ItemSuite struct{}
+
+var _ = Suite(&ItemSuite{})
+
+func (s *ItemSuite) Test_CanBeUsedBy(c *C) {
+	item, err := NewItemFromJson([]byte(`{"id": 76749, "requiredLevel": 85, "allowableClasses": [2, 6], "allowableRaces": [1, 3, 4]}`))
+	c.Assert(err, IsNil)
+	c.Assert(item.AllowableClasses, DeepEquals, []int{2, 6})
+	c.Assert(item.CanBeUsedBy(2, 3, 85), Equals, true)
+	c.Assert(item.CanBeUsedBy(2, 3, 84), Equals, false)
+	c.Assert(item.CanBeUsedBy(1, 3, 90), Equals, false)
+	c.Assert(item.CanBeUsedBy(6, 2, 90), Equals, false)
+
+	unrestricted := &Item{Id: 18803}
+	c.Assert(unrestricted.CanBeUsedBy(8, 10, 1), Equals, true)
+}
+
+func itemServer(c *C) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/item/18803":
+			w.Write([]byte(`{"id": 18803, "description": "Property of Finkle Einhorn, Grandmaster Adventurer", "name": "Finkle's Lava Dredger", "icon": "inv_gizmo_02", "stackable": 1, "itemBind": 1,
+				"bonusStats": [{"stat": 51, "amount": 15}, {"stat": 5, "amount": 16}, {"stat": 7, "amount": 15}, {"stat": 6, "amount": 6}],
+				"itemSpells": [], "buyPrice": 474384, "itemClass": 2, "itemSubClass": 6, "containerSlots": 0,
+				"weaponInfo": {"damage": {"min": 81, "max": 122, "exactMin": 81.0, "exactMax": 122.0}, "weaponSpeed": 2.9, "dps": 35.0},
+				"inventoryType": 17, "equippable": true, "itemLevel": 70, "maxCount": 0, "maxDurability": 100, "minFactionId": 0, "minReputation": 0,
+				"quality": 4, "sellPrice": 94876, "requiredSkill": 0, "requiredLevel": 60, "requiredSkillRank": 0, "baseArmor": 0, "hasSockets": false, "isAuctionable": false, "armor": 0,
+				"displayInfoId": 31264, "nameDescription": "", "nameDescriptionColor": "000000", "upgradable": true, "heroicTooltip": false, "context": "", "bonusLists": [], "availableContexts": [""]}`))
+		case "/wow/item/113939":
+			w.Write([]byte(`{"id": 113939, "name": "Butcher's Terrible Tenderizer", "quality": 4, "itemLevel": 655, "inventoryType": 13, "context": "", "availableContexts": ["raid-normal", "raid-heroic", "raid-mythic"]}`))
+		case "/wow/item/113939/raid-heroic":
+			w.Write([]byte(`{"id": 113939, "name": "Butcher's Terrible Tenderizer", "quality": 4, "itemLevel": 670, "inventoryType": 13, "context": "raid-heroic", "bonusLists": [566], "availableContexts": ["raid-normal", "raid-heroic", "raid-mythic"]}`))
+		case "/wow/item/78687":
+			w.Write([]byte(`{"id": 78687, "name": "Shoulders of the Corrupted Vanquisher", "quality": 4, "itemLevel": 397, "inventoryType": 3, "itemClass": 4, "itemSubClass": 4, "armor": 4287, "baseArmor": 4287,
+				"requiredLevel": 85, "allowableClasses": [1, 2, 6], "bonusStats": [{"stat": 4, "amount": 281}, {"stat": 7, "amount": 471}],
+				"itemSpells": [{"spellId": 105706, "spell": {"id": 105706, "name": "Hit Rating"}, "nCharges": 0, "consumable": false, "categoryId": 0, "trigger": "ON_EQUIP"}], "socketInfo": {"sockets": [{"type": "PRISMATIC"}]}, "hasSockets": true, "context": ""}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func (s *ItemSuite) Test_GetItem_weapon(c *C) {
+	server := itemServer(c)
+	defer server.Close()
+
+	item, err := testClient(server).GetItem(18803)
+	c.Assert(err, IsNil)
+	c.Assert(item.Name, Equals, "Finkle's Lava Dredger")
+	c.Assert(item.Quality, Equals, 4)
+	c.Assert(item.ItemLevel, Equals, 70)
+	c.Assert(item.RequiredLevel, Equals, 60)
+	c.Assert(item.Icon, Equals, "inv_gizmo_02")
+	c.Assert(len(item.Stats), Equals, 4)
+	c.Assert(item.WeaponInfo.Damage.Max, Equals, 122)
+	c.Assert(item.InventoryType, Equals, InventoryTypeTwoHand)
+}
+
+func (s *ItemSuite) Test_GetItem_armor(c *C) {
+	server := itemServer(c)
+	defer server.Close()
+
+	item, err := testClient(server).GetItem(78687)
+	c.Assert(err, IsNil)
+	c.Assert(item.Armor, Equals, 4287)
+	c.Assert(item.BonusStats[1].Amount, Equals, 471)
+	c.Assert(item.ItemSpells[0].Spell.Name, Equals, "Hit Rating")
+	c.Assert(item.ItemSpells[0].Trigger, Equals, "ON_EQUIP")
+	c.Assert(item.SocketCount(), Equals, 1)
+	c.Assert(item.CanBeUsedBy(8, 1, 85), Equals, false)
+}
+
+func (s *ItemSuite) Test_GetItemWithContext(c *C) {
+	server := itemServer(c)
+	defer server.Close()
+	client := testClient(server)
+
+	item, err := client.GetItem(113939)
+	c.Assert(err, IsNil)
+	c.Assert(item.AvailableContexts, DeepEquals, []string{"raid-normal", "raid-heroic", "raid-mythic"})
+
+	item, err = client.GetItemWithContext(113939, item.AvailableContexts[1])
+	c.Assert(err, IsNil)
+	c.Assert(item.Context, Equals, "raid-heroic")
+	c.Assert(item.ItemLevel, Equals, 670)
+	c.Assert(item.BonusLists, DeepEquals, []int{566})
+}
@@ -0,0 +1,94 @@
+package wow
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LocaleDiff lists the localized strings of a character that differ
+// between two locales.
+type LocaleDiff struct {
+	LocaleA     string
+	LocaleB     string
+	Differences []*LocalizedField
+}
+
+// LocalizedField is a string of a character in two locales. A or B is
+// empty if the string is missing in that locale.
+type LocalizedField struct {
+	// Field identifies the string, e.g. "title/123", "spec/0" or
+	// "profession/171".
+	Field string
+	A     string
+	B     string
+}
+
+// CompareLocales fetches the character in locales a and b, which must
+// be valid for the client's region, and reports the localized strings
+// (titles, guild name, specs and professions) that differ between
+// them or are missing in one, ordered by Field.
+func (a *ApiClient) CompareLocales(realm string, characterName string, localeA string, localeB string) (*LocaleDiff, error) {
+	for _, locale := range []string{localeA, localeB} {
+		err := a.validateLocale(locale)
+		if err != nil {
+			return nil, err
+		}
+	}
+	stringsA, err := a.localizedStrings(realm, characterName, localeA)
+	if err != nil {
+		return nil, err
+	}
+	stringsB, err := a.localizedStrings(realm, characterName, localeB)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &LocaleDiff{LocaleA: localeA, LocaleB: localeB, Differences: make([]*LocalizedField, 0)}
+	fields := make(map[string]bool)
+	for field := range stringsA {
+		fields[field] = true
+	}
+	for field := range stringsB {
+		fields[field] = true
+	}
+	for field := range fields {
+		if stringsA[field] != stringsB[field] {
+			diff.Differences = append(diff.Differences, &LocalizedField{Field: field, A: stringsA[field], B: stringsB[field]})
+		}
+	}
+	sort.Slice(diff.Differences, func(i, j int) bool {
+		return diff.Differences[i].Field < diff.Differences[j].Field
+	})
+	return diff, nil
+}
+
+// localizedStrings fetches the character in locale and returns its
+// localized strings keyed by LocalizedField.Field.
+func (a *ApiClient) localizedStrings(realm string, characterName string, locale string) (map[string]string, error) {
+	client := a.WithContext(WithContextLocale(a.Context(), locale))
+	char, err := client.GetCharacterWithFields(realm, characterName, []string{"guild", "professions", "talents", "titles"})
+	if err != nil {
+		return nil, err
+	}
+
+	localized := make(map[string]string)
+	for _, title := range char.Titles {
+		localized[fmt.Sprintf("title/%d", title.Id)] = title.Name
+	}
+	if char.Guild != nil {
+		localized["guild"] = char.Guild.Name
+	}
+	for _, talents := range char.Talents {
+		if talents.Spec != nil {
+			localized[fmt.Sprintf("spec/%d", talents.Spec.Order)] = talents.Spec.Name
+		}
+	}
+	if char.Professions != nil {
+		for _, list := range [][]*Profession{char.Professions.Primary, char.Professions.Secondary} {
+			for _, profession := range list {
+				localized[fmt.Sprintf("profession/%d", profession.Id)] = profession.Name
+			}
+		}
+	}
+	return localized, nil
+}
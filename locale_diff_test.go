@@ -0,0 +1,42 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type LocaleDiffSuite struct{}
+
+var _ = Suite(&LocaleDiffSuite{})
+
+func (s *LocaleDiffSuite) Test_CompareLocales(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("locale") {
+		case "en_GB":
+			w.Write([]byte(`{"name": "Capoferro", "guild": {"name": "Phoenix"},
+				"titles": [{"id": 1, "name": "%s the Explorer"}, {"id": 2, "name": "Private %s"}],
+				"talents": [{"spec": {"name": "Frost", "order": 2}}],
+				"professions": {"primary": [{"id": 171, "name": "Alchemy"}], "secondary": [{"id": 185, "name": "Cooking"}]}}`))
+		case "fr_FR":
+			w.Write([]byte(`{"name": "Capoferro", "guild": {"name": "Phoenix"},
+				"titles": [{"id": 1, "name": "%s l'Explorateur"}],
+				"talents": [{"spec": {"name": "Givre", "order": 2}}],
+				"professions": {"primary": [{"id": 171, "name": "Alchimie"}], "secondary": [{"id": 185, "name": "Cooking"}]}}`))
+		}
+	}))
+	defer server.Close()
+	client := testRegionClient(server, "EU", "")
+
+	diff, err := client.CompareLocales("runetotem", "Capoferro", "en_GB", "fr_FR")
+	c.Assert(err, IsNil)
+	c.Assert(diff.LocaleB, Equals, "fr_FR")
+	c.Assert(len(diff.Differences), Equals, 4)
+	c.Assert(*diff.Differences[0], Equals, LocalizedField{Field: "profession/171", A: "Alchemy", B: "Alchimie"})
+	c.Assert(*diff.Differences[1], Equals, LocalizedField{Field: "spec/2", A: "Frost", B: "Givre"})
+	c.Assert(*diff.Differences[2], Equals, LocalizedField{Field: "title/1", A: "%s the Explorer", B: "%s l'Explorateur"})
+	c.Assert(*diff.Differences[3], Equals, LocalizedField{Field: "title/2", A: "Private %s", B: ""})
+
+	_, err = client.CompareLocales("runetotem", "Capoferro", "en_GB", "en_US")
+	c.Assert(err.Error(), Equals, "Locale 'en_US' is not valid for region 'EU'")
+}
@@ -6,9 +6,14 @@ type Mount struct {
 	CreatureId int
 	ItemId     int
 	Quality    int
+	QualityId  int
 	Icon       string
 	IsGround   bool
 	IsFlying   bool
 	IsAquatic  bool
 	IsJumping  bool
 }
+
+func (m *Mount) GetName() string {
+	return m.Name
+}
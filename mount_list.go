@@ -5,3 +5,7 @@ type MountList struct {
 	NumNotCollected int
 	Collected       []*Mount
 }
+
+type mountMasterList struct {
+	Mounts []*Mount
+}
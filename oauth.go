@@ -0,0 +1,89 @@
+package wow
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// OAuth token endpoints per region.
+var tokenURLs = map[string]string{
+	"US": "https://us.battle.net/oauth/token",
+	"EU": "https://eu.battle.net/oauth/token",
+	"KR": "https://kr.battle.net/oauth/token",
+	"TW": "https://tw.battle.net/oauth/token",
+	"ZH": "https://www.battlenet.com.cn/oauth/token",
+}
+
+// TokenEndpoint is the path given to ApiClient.DoerFor when fetching
+// OAuth access tokens.
+const TokenEndpoint = "oauth/token"
+
+type accessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// FetchAccessToken requests an OAuth access token for the client
+// credentials from the region's token endpoint (or TokenURL, if set),
+// and returns it with the time it expires. Set it as the client's
+// AccessToken to authenticate requests with it.
+func (a *ApiClient) FetchAccessToken(clientId string, clientSecret string) (string, time.Time, error) {
+	tokenURL := a.TokenURL
+	if tokenURL == "" {
+		tokenURL = tokenURLs[regionCode(a.region)]
+	}
+	if tokenURL == "" {
+		tokenURL = tokenURLs["US"]
+	}
+	form := url.Values{"grant_type": {"client_credentials"}}
+	request, err := http.NewRequestWithContext(a.Context(), "POST", tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	request.SetBasicAuth(clientId, clientSecret)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	requested := time.Now()
+	response, err := a.doerFor(TokenEndpoint).Do(request)
+	if err != nil {
+		return "", time.Time{}, a.contextError(err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", time.Time{}, a.contextError(err)
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return "", time.Time{}, newOAuthError(response, body)
+	}
+
+	token := &accessTokenResponse{}
+	err = json.Unmarshal(body, token)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token.AccessToken, requested.Add(time.Duration(token.ExpiresIn) * time.Second), nil
+}
+
+// newOAuthError builds the error for a failed token response, whose
+// body is {"error": "...", "error_description": "..."} rather than
+// the API's usual {"reason": "..."}.
+func newOAuthError(response *http.Response, body []byte) *ApiError {
+	apiErr := newApiError(response, body)
+	oauthErr := &struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}{}
+	json.Unmarshal(body, oauthErr)
+	if oauthErr.ErrorDescription != "" {
+		apiErr.Reason = oauthErr.ErrorDescription
+	} else if oauthErr.Error != "" {
+		apiErr.Reason = oauthErr.Error
+	}
+	return apiErr
+}
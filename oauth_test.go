@@ -0,0 +1,59 @@
+package wow
+
+import (
+	"errors"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+type OAuthSuite struct{}
+
+var _ = Suite(&OAuthSuite{})
+
+func (s *OAuthSuite) Test_FetchAccessToken(c *C) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			c.Check(r.Method, Equals, "POST")
+			c.Check(r.FormValue("grant_type"), Equals, "client_credentials")
+			id, secret, _ := r.BasicAuth()
+			if id != "client" || secret != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error": "unauthorized", "error_description": "Bad credentials"}`))
+				return
+			}
+			w.Write([]byte(`{"access_token": "token123", "token_type": "bearer", "expires_in": 86399}`))
+		default:
+			c.Check(r.URL.Query().Get("apikey"), Equals, "")
+			authorization = r.Header.Get("Authorization")
+			w.Write([]byte(`{"id": 13146, "classes": []}`))
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.TokenURL = server.URL + "/oauth/token"
+
+	token, expires, err := client.FetchAccessToken("client", "secret")
+	c.Assert(err, IsNil)
+	c.Assert(token, Equals, "token123")
+	c.Assert(expires.After(time.Now().Add(23*time.Hour)), Equals, true)
+
+	_, _, err = client.FetchAccessToken("client", "wrong")
+	var apiErr *ApiError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusUnauthorized)
+	c.Assert(apiErr.Reason, Equals, "Bad credentials")
+
+	client.AccessToken = token
+	client.Secret = "old secret"
+	client.PublicKey = "public"
+	_, err = client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(authorization, Equals, "Bearer token123")
+	_, err = client.GetClasses()
+	c.Assert(err, IsNil)
+	c.Assert(authorization, Equals, "Bearer token123")
+}
@@ -0,0 +1,73 @@
+package wow
+
+import (
+	"log"
+)
+
+// Observer is notified of noteworthy events while the client talks to
+// the API. Set ApiClient.Observer to receive them.
+type Observer interface {
+	Observe(event *Event)
+}
+
+// ObserverFunc adapts an ordinary function to the Observer interface.
+type ObserverFunc func(event *Event)
+
+func (f ObserverFunc) Observe(event *Event) {
+	f(event)
+}
+
+type EventType int
+
+const (
+	// The API flagged an endpoint as deprecated via its Deprecation
+	// or Sunset headers.
+	DeprecationEvent EventType = iota
+	// A cached resource was served without a request.
+	CacheHitEvent
+	// A resource wasn't cached and had to be requested.
+	CacheMissEvent
+	// An API request with a request id got a response, whose status
+	// is the event's message.
+	RequestEvent
+)
+
+func (t EventType) String() string {
+	switch t {
+	case DeprecationEvent:
+		return "deprecation"
+	case CacheHitEvent:
+		return "cache hit"
+	case CacheMissEvent:
+		return "cache miss"
+	case RequestEvent:
+		return "request"
+	}
+	return "unknown"
+}
+
+type Event struct {
+	Type    EventType
+	Path    string
+	Message string
+	// RequestId is the X-Request-ID of the request the event is about,
+	// if it had one.
+	RequestId string
+}
+
+// LogObserver returns an Observer that writes every event to logger.
+func LogObserver(logger *log.Logger) Observer {
+	return ObserverFunc(func(event *Event) {
+		if event.RequestId != "" {
+			logger.Printf("wow: %s: %s: %s (request %s)", event.Type, event.Path, event.Message, event.RequestId)
+			return
+		}
+		logger.Printf("wow: %s: %s: %s", event.Type, event.Path, event.Message)
+	})
+}
+
+func (a *ApiClient) notify(event *Event) {
+	if a.Observer != nil {
+		a.Observer.Observe(event)
+	}
+}
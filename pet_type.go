@@ -8,3 +8,7 @@ type PetType struct {
 	StrongAgainstId int
 	WeakAgainstId   int
 }
+
+func (p *PetType) GetName() string {
+	return p.Name
+}
@@ -8,3 +8,93 @@ type Profession struct {
 	Max     int
 	Recipes []int
 }
+
+// ProfessionTier is a training level of a profession. Each expansion
+// added a tier that raised the skill cap. The API reports a single
+// rank per profession rather than a skill level per expansion, so the
+// tier is what can be told from it.
+type ProfessionTier int
+
+const (
+	UnknownTier ProfessionTier = iota
+	ApprenticeTier
+	JourneymanTier
+	ExpertTier
+	ArtisanTier
+	MasterTier
+	GrandMasterTier
+	IllustriousTier
+	ZenMasterTier
+	DraenorMasterTier
+)
+
+// Skill caps of the tiers, indexed by tier.
+var professionTierCaps = []int{0, 75, 150, 225, 300, 375, 450, 525, 600, 700}
+
+var professionTierNames = []string{
+	"Unknown",
+	"Apprentice",
+	"Journeyman",
+	"Expert",
+	"Artisan",
+	"Master",
+	"Grand Master",
+	"Illustrious",
+	"Zen Master",
+	"Draenor Master",
+}
+
+// Expansions that introduced each tier, indexed by tier.
+var professionTierExpansions = []string{
+	"",
+	"Classic",
+	"Classic",
+	"Classic",
+	"Classic",
+	"The Burning Crusade",
+	"Wrath of the Lich King",
+	"Cataclysm",
+	"Mists of Pandaria",
+	"Warlords of Draenor",
+}
+
+func (t ProfessionTier) String() string {
+	if t < 0 || int(t) >= len(professionTierNames) {
+		return professionTierNames[UnknownTier]
+	}
+	return professionTierNames[t]
+}
+
+// Expansion returns the name of the expansion that introduced the
+// tier, or "" if the tier is unknown.
+func (t ProfessionTier) Expansion() string {
+	if t < 0 || int(t) >= len(professionTierExpansions) {
+		return ""
+	}
+	return professionTierExpansions[t]
+}
+
+// Cap returns the highest rank reachable in the tier.
+func (t ProfessionTier) Cap() int {
+	if t < 0 || int(t) >= len(professionTierCaps) {
+		return 0
+	}
+	return professionTierCaps[t]
+}
+
+// Tier returns the tier the character has trained, from the
+// profession's skill cap (Max).
+func (p *Profession) Tier() ProfessionTier {
+	for tier, cap := range professionTierCaps {
+		if cap == p.Max && cap > 0 {
+			return ProfessionTier(tier)
+		}
+	}
+	return UnknownTier
+}
+
+// IsMaxed reports whether the profession's rank has reached the cap of
+// its tier.
+func (p *Profession) IsMaxed() bool {
+	return p.Max > 0 && p.Rank >= p.Max
+}
@@ -0,0 +1,25 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type ProfessionSuite struct{}
+
+var _ = Suite(&ProfessionSuite{})
+
+func (s *ProfessionSuite) Test_Tier(c *C) {
+	p := &Profession{Id: 171, Name: "Alchemy", Rank: 575, Max: 600}
+	c.Assert(p.Tier(), Equals, ZenMasterTier)
+	c.Assert(p.Tier().String(), Equals, "Zen Master")
+	c.Assert(p.Tier().Expansion(), Equals, "Mists of Pandaria")
+	c.Assert(p.Tier().Cap(), Equals, 600)
+	c.Assert(p.IsMaxed(), Equals, false)
+
+	p = &Profession{Id: 794, Name: "Archaeology", Rank: 300, Max: 300}
+	c.Assert(p.Tier(), Equals, ArtisanTier)
+	c.Assert(p.IsMaxed(), Equals, true)
+
+	c.Assert((&Profession{Max: 0}).Tier(), Equals, UnknownTier)
+	c.Assert((&Profession{Max: 0}).Tier().Expansion(), Equals, "")
+}
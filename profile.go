@@ -0,0 +1,94 @@
+package wow
+
+// ProfileOptions selects what GetCharacterProfile derives.
+type ProfileOptions struct {
+	ItemLevel   bool
+	Spec        bool
+	Guild       bool
+	Collections bool
+	// ClassName resolves the character's class id to its localized
+	// name, which takes a (cached) classes request.
+	ClassName bool
+}
+
+// Profile is a summary of a character as shown on armory pages. Only
+// the parts selected in the ProfileOptions are filled in.
+type Profile struct {
+	Character *Character
+	ClassName string
+	// ItemLevel is the average item level of the equipped items.
+	ItemLevel int
+	Spec      *Spec
+	SpecId    int
+	Role      Role
+	Guild     *SimpleGuild
+	// CollectionPercent is the share of all mounts and pets that the
+	// character has collected, from 0 to 100.
+	CollectionPercent float64
+}
+
+func (o ProfileOptions) fields() []string {
+	fields := make([]string, 0)
+	if o.ItemLevel {
+		fields = append(fields, "items")
+	}
+	if o.Spec {
+		fields = append(fields, "talents")
+	}
+	if o.Guild {
+		fields = append(fields, "guild")
+	}
+	if o.Collections {
+		fields = append(fields, "mounts", "pets")
+	}
+	return fields
+}
+
+// GetCharacterProfile fetches the character with just the fields opts
+// needs, in a single character request, and derives the profile from
+// it.
+func (a *ApiClient) GetCharacterProfile(realm string, characterName string, opts ProfileOptions) (*Profile, error) {
+	char, err := a.GetCharacterWithFields(realm, characterName, opts.fields())
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &Profile{Character: char, Role: RoleUnknown}
+	if opts.ClassName {
+		profile.ClassName, err = char.Class()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.ItemLevel && char.Items != nil {
+		profile.ItemLevel = char.Items.AverageItemLevelEquipped
+	}
+	if opts.Spec {
+		profile.Spec = char.ActiveSpec()
+		profile.SpecId, _ = char.ActiveSpecId()
+		profile.Role = char.ActiveRole()
+	}
+	if opts.Guild {
+		profile.Guild = char.Guild
+	}
+	if opts.Collections {
+		profile.CollectionPercent = collectionPercent(char.Mounts, char.Pets)
+	}
+	return profile, nil
+}
+
+func collectionPercent(mounts *MountList, pets *PetList) float64 {
+	collected, total := 0, 0
+	if mounts != nil {
+		collected += mounts.NumCollected
+		total += mounts.NumCollected + mounts.NumNotCollected
+	}
+	if pets != nil {
+		collected += pets.NumCollected
+		total += pets.NumCollected + pets.NumNotCollected
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(collected) * 100 / float64(total)
+}
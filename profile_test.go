@@ -0,0 +1,50 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type ProfileSuite struct{}
+
+var _ = Suite(&ProfileSuite{})
+
+func (s *ProfileSuite) Test_GetCharacterProfile(c *C) {
+	characterRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/character/runetotem/Kael":
+			characterRequests++
+			c.Check(r.URL.Query().Get("fields"), Equals, "guild,items,mounts,pets,talents")
+			w.Write([]byte(`{"name": "Kael", "class": 8, "level": 90,
+				"items": {"averageItemLevel": 560, "averageItemLevelEquipped": 555},
+				"talents": [{"selected": true, "spec": {"name": "Frost", "role": "DPS", "order": 2}}, {"spec": {"name": "Arcane", "role": "DPS", "order": 0}}],
+				"guild": {"name": "Phoenix", "realm": "Runetotem"},
+				"mounts": {"numCollected": 30, "numNotCollected": 70},
+				"pets": {"numCollected": 20, "numNotCollected": 80}}`))
+		case "/wow/data/character/classes":
+			w.Write([]byte(`{"classes": [{"id": 8, "mask": 128, "powerType": "mana", "name": "Mage"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	profile, err := client.GetCharacterProfile("runetotem", "Kael", ProfileOptions{ItemLevel: true, Spec: true, Guild: true, Collections: true, ClassName: true})
+	c.Assert(err, IsNil)
+	c.Assert(characterRequests, Equals, 1)
+	c.Assert(profile.ClassName, Equals, "Mage")
+	c.Assert(profile.ItemLevel, Equals, 555)
+	c.Assert(profile.Spec.Name, Equals, "Frost")
+	c.Assert(profile.SpecId, Equals, 64)
+	c.Assert(profile.Role, Equals, RoleDPS)
+	c.Assert(profile.Guild.Name, Equals, "Phoenix")
+	c.Assert(profile.CollectionPercent, Equals, 25.0)
+}
+
+func (s *ProfileSuite) Test_ProfileOptions_fields(c *C) {
+	c.Assert(ProfileOptions{}.fields(), DeepEquals, []string{})
+	c.Assert(ProfileOptions{ClassName: true, ItemLevel: true}.fields(), DeepEquals, []string{"items"})
+}
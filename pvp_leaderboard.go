@@ -1,5 +1,63 @@
 package wow
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 type pvpLeaderboard struct {
 	Rows []*PvPLeaderboardRow
 }
+
+// Leaderboard brackets, as given to GetPvPLeaderboard.
+var LeaderboardBrackets = []string{"2v2", "3v3", "5v5", "rbg"}
+
+func validateLeaderboardBracket(bracket string) error {
+	for _, valid := range LeaderboardBrackets {
+		if bracket == valid {
+			return nil
+		}
+	}
+	return errors.New(fmt.Sprintf("Bracket '%s' is not valid; valid brackets are %s", bracket, strings.Join(LeaderboardBrackets, ", ")))
+}
+
+// LeaderboardErrors holds the errors of the brackets that
+// GetAllLeaderboards couldn't fetch, keyed by bracket.
+type LeaderboardErrors map[string]error
+
+func (e LeaderboardErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, bracket := range LeaderboardBrackets {
+		if err, ok := e[bracket]; ok {
+			messages = append(messages, fmt.Sprintf("%s: %s", bracket, err))
+		}
+	}
+	return "Could not fetch leaderboards: " + strings.Join(messages, "; ")
+}
+
+// GetAllLeaderboards fetches the leaderboard of every bracket
+// concurrently, keyed by bracket. Brackets that fail are left out and
+// their errors returned as LeaderboardErrors.
+func (a *ApiClient) GetAllLeaderboards() (map[string][]*PvPLeaderboardRow, error) {
+	rows := make([][]*PvPLeaderboardRow, len(LeaderboardBrackets))
+	errs := a.forEach(len(LeaderboardBrackets), 0, func(i int) error {
+		var err error
+		rows[i], err = a.GetPvPLeaderboard(LeaderboardBrackets[i])
+		return err
+	})
+
+	leaderboards := make(map[string][]*PvPLeaderboardRow)
+	failed := make(LeaderboardErrors)
+	for i, bracket := range LeaderboardBrackets {
+		if errs[i] != nil {
+			failed[bracket] = errs[i]
+		} else {
+			leaderboards[bracket] = rows[i]
+		}
+	}
+	if len(failed) > 0 {
+		return leaderboards, failed
+	}
+	return leaderboards, nil
+}
@@ -0,0 +1,47 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type PvPLeaderboardSuite struct{}
+
+var _ = Suite(&PvPLeaderboardSuite{})
+
+func (s *PvPLeaderboardSuite) Test_GetAllLeaderboards(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wow/leaderboard/5v5" {
+			w.Write([]byte(`not json`))
+			return
+		}
+		w.Write([]byte(`{"rows": [{"ranking": 1, "rating": 2700, "name": "Capoferro"}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	leaderboards, err := client.GetAllLeaderboards()
+	c.Assert(len(leaderboards), Equals, 3)
+	c.Assert(leaderboards["rbg"][0].Name, Equals, "Capoferro")
+	failed, ok := err.(LeaderboardErrors)
+	c.Assert(ok, Equals, true)
+	c.Assert(len(failed), Equals, 1)
+	c.Assert(failed["5v5"], NotNil)
+}
+
+func (s *PvPLeaderboardSuite) Test_GetPvPLeaderboard(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/leaderboard/3v3")
+		w.Write([]byte(`{"rows": [{"ranking": 1, "rating": 2912, "name": "Capoferro", "realmId": 1, "realmName": "Runetotem", "realmSlug": "runetotem", "raceId": 10, "classId": 8, "specId": 63, "factionId": 1, "genderId": 0, "seasonWins": 212, "seasonLosses": 71, "weeklyWins": 20, "weeklyLosses": 4}]}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	rows, err := client.GetPvPLeaderboard("3v3")
+	c.Assert(err, IsNil)
+	c.Assert(*rows[0], DeepEquals, PvPLeaderboardRow{Ranking: 1, Rating: 2912, Name: "Capoferro", RealmId: 1, RealmName: "Runetotem", RealmSlug: "runetotem", RaceId: 10, ClassId: 8, SpecId: 63, FactionId: 1, SeasonWins: 212, SeasonLosses: 71, WeeklyWins: 20, WeeklyLosses: 4})
+
+	_, err = client.GetPvPLeaderboard("4v4")
+	c.Assert(err.Error(), Equals, "Bracket '4v4' is not valid; valid brackets are 2v2, 3v3, 5v5, rbg")
+}
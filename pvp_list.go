@@ -3,3 +3,32 @@ package wow
 type PvPList struct {
 	Brackets *BracketList
 }
+
+// Bracket returns the named bracket ("2v2", "3v3", "5v5" or "rbg"), or
+// nil if it's unknown or wasn't returned.
+func (p *PvPList) Bracket(bracket string) *ArenaBracket {
+	if p.Brackets == nil {
+		return nil
+	}
+	switch bracket {
+	case "2v2":
+		return p.Brackets.ArenaBracket2v2
+	case "3v3":
+		return p.Brackets.ArenaBracket3v3
+	case "5v5":
+		return p.Brackets.ArenaBracket5v5
+	case "rbg":
+		return p.Brackets.ArenaBracketRBG
+	}
+	return nil
+}
+
+// WinRate returns the season win rate of the named bracket (see
+// Bracket). It's 0 for unknown brackets and brackets without games.
+func (p *PvPList) WinRate(bracket string) float64 {
+	b := p.Bracket(bracket)
+	if b == nil {
+		return 0
+	}
+	return b.SeasonWinRate()
+}
@@ -0,0 +1,24 @@
+package wow
+
+import (
+	"encoding/json"
+	. "launchpad.net/gocheck"
+)
+
+type PvPListSuite struct{}
+
+var _ = Suite(&PvPListSuite{})
+
+func (s *PvPListSuite) Test_WinRate(c *C) {
+	p := &PvPList{}
+	err := json.Unmarshal([]byte(`{"brackets": {
+		"ARENA_BRACKET_2v2": {"slug": "2v2", "rating": 1800, "weeklyPlayed": 4, "weeklyWon": 1, "weeklyLost": 3, "seasonPlayed": 40, "seasonWon": 30, "seasonLost": 10},
+		"ARENA_BRACKET_3v3": {"slug": "3v3", "rating": 1500}
+	}}`), p)
+	c.Assert(err, IsNil)
+	c.Assert(p.WinRate("2v2"), Equals, 0.75)
+	c.Assert(p.Bracket("2v2").WeeklyWinRate(), Equals, 0.25)
+	c.Assert(p.WinRate("3v3"), Equals, 0.0)
+	c.Assert(p.WinRate("rbg"), Equals, 0.0)
+	c.Assert(p.WinRate("1v1"), Equals, 0.0)
+}
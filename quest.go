@@ -8,3 +8,16 @@ type Quest struct {
 	SuggestedPartyMembers int
 	Title                 string
 }
+
+// ResolveQuests fetches the quests with the given ids, e.g. a
+// character's Quests, concurrently and without duplicates. Quests are
+// cached, so resolving them again doesn't make requests. Quests that
+// fail to load are left out and their errors returned.
+func (a *ApiClient) ResolveQuests(ids []int) (map[int]*Quest, []error) {
+	resolved, failed := a.resolveIds(ids, 0, "quest/%d", func() interface{} { return &Quest{} })
+	quests := make(map[int]*Quest)
+	for id, quest := range resolved {
+		quests[id] = quest.(*Quest)
+	}
+	return quests, loadErrors("quest", failed)
+}
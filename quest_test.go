@@ -0,0 +1,41 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type QuestSuite struct{}
+
+var _ = Suite(&QuestSuite{})
+
+func (s *QuestSuite) Test_ResolveQuests(c *C) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/wow/quest/13146":
+			w.Write([]byte(`{"id": 13146, "title": "Generosity Abounds"}`))
+		case "/wow/quest/24":
+			w.Write([]byte(`{"id": 24, "title": "Shrine of Dath'Remar"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	quests, errs := client.ResolveQuests([]int{13146, 24, 13146, 99999})
+	c.Assert(len(quests), Equals, 2)
+	c.Assert(quests[24].Title, Equals, "Shrine of Dath'Remar")
+	c.Assert(len(errs), Equals, 1)
+	c.Assert(strings.HasPrefix(errs[0].Error(), "Could not load quest 99999"), Equals, true)
+	c.Assert(requests, Equals, 3)
+
+	quests, errs = client.ResolveQuests([]int{13146, 24})
+	c.Assert(len(quests), Equals, 2)
+	c.Assert(len(errs), Equals, 0)
+	c.Assert(requests, Equals, 3)
+}
@@ -6,3 +6,7 @@ type Race struct {
 	Side string
 	Name string
 }
+
+func (r *Race) GetName() string {
+	return r.Name
+}
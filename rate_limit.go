@@ -0,0 +1,91 @@
+package wow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Limiter paces API requests. A *rate.Limiter from
+// golang.org/x/time/rate is a Limiter, as is NewRateLimiter's.
+type Limiter interface {
+	// Wait blocks until a request may be sent, or returns an error if
+	// ctx is done first.
+	Wait(ctx context.Context) error
+}
+
+// NewRateLimiter returns a Limiter that spaces requests evenly at
+// perSecond requests per second, without bursts. perSecond must be
+// positive.
+func NewRateLimiter(perSecond float64) (Limiter, error) {
+	if !(perSecond > 0) {
+		return nil, errors.New(fmt.Sprintf("Rate limit must be positive, not %v", perSecond))
+	}
+	return &intervalLimiter{
+		perSecond: perSecond,
+		interval:  time.Duration(float64(time.Second) / perSecond),
+	}, nil
+}
+
+// WithRateLimit limits the client to perSecond requests per second.
+// See NewRateLimiter.
+func WithRateLimit(perSecond float64) Option {
+	return func(a *ApiClient) error {
+		limiter, err := NewRateLimiter(perSecond)
+		if err != nil {
+			return err
+		}
+		a.Limiter = limiter
+		return nil
+	}
+}
+
+type intervalLimiter struct {
+	mutex     sync.Mutex
+	perSecond float64
+	interval  time.Duration
+	next      time.Time
+}
+
+func (l *intervalLimiter) Wait(ctx context.Context) error {
+	l.mutex.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	reserved := l.next
+	delay := reserved.Sub(now)
+	l.next = reserved.Add(l.interval)
+	l.mutex.Unlock()
+
+	if delay == 0 {
+		if err := ctx.Err(); err != nil {
+			l.cancel(reserved)
+			return err
+		}
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel(reserved)
+		return ctx.Err()
+	}
+}
+
+// cancel gives back the slot reserved by a Wait that gave up, so later
+// callers don't wait for a request that won't be sent. Only the last
+// slot can be given back: later slots are already promised to other
+// callers, and moving them up would send two requests at once.
+func (l *intervalLimiter) cancel(reserved time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.next.Equal(reserved.Add(l.interval)) {
+		l.next = reserved
+	}
+}
@@ -0,0 +1,104 @@
+package wow
+
+import (
+	"context"
+	. "launchpad.net/gocheck"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+)
+
+type RateLimitSuite struct{}
+
+var _ = Suite(&RateLimitSuite{})
+
+func (s *RateLimitSuite) Test_Limiter(c *C) {
+	var mutex sync.Mutex
+	times := make([]time.Time, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		times = append(times, time.Now())
+		mutex.Unlock()
+		w.Write([]byte(`{"id": 13146}`))
+	}))
+	defer server.Close()
+	client := testClient(server)
+	c.Assert(WithRateLimit(50)(client), IsNil)
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetQuest(13146)
+			c.Check(err, IsNil)
+		}()
+	}
+	wg.Wait()
+
+	c.Assert(len(times), Equals, 5)
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	for i, t := range times {
+		// Requests can arrive late, but never before their slot.
+		c.Assert(t.Sub(start) >= time.Duration(i)*20*time.Millisecond, Equals, true)
+	}
+}
+
+func (s *RateLimitSuite) Test_Limiter_cancelled(c *C) {
+	limiter, _ := NewRateLimiter(1)
+	c.Assert(limiter.Wait(context.Background()), IsNil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	c.Assert(limiter.Wait(ctx), Equals, context.DeadlineExceeded)
+
+	// The cancelled wait's slot was given back, so the next one is
+	// only a second after the first.
+	limiter, _ = NewRateLimiter(20)
+	c.Assert(limiter.Wait(context.Background()), IsNil)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.Assert(limiter.Wait(cancelled), Equals, context.Canceled)
+	start := time.Now()
+	c.Assert(limiter.Wait(context.Background()), IsNil)
+	c.Assert(time.Since(start) < 75*time.Millisecond, Equals, true)
+}
+
+func (s *RateLimitSuite) Test_Limiter_cancelledInLine(c *C) {
+	limiter, _ := NewRateLimiter(20)
+	c.Assert(limiter.Wait(context.Background()), IsNil)
+
+	// The second caller gives up while the third waits behind it.
+	second, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	secondDone := make(chan error)
+	go func() { secondDone <- limiter.Wait(second) }()
+	time.Sleep(5 * time.Millisecond)
+	third := make(chan time.Time)
+	go func() {
+		c.Check(limiter.Wait(context.Background()), IsNil)
+		third <- time.Now()
+	}()
+	c.Assert(<-secondDone, Equals, context.DeadlineExceeded)
+
+	// The fourth still waits its turn after the third.
+	c.Assert(limiter.Wait(context.Background()), IsNil)
+	fourth := time.Now()
+	c.Assert(fourth.Sub(<-third) >= 40*time.Millisecond, Equals, true)
+}
+
+func (s *RateLimitSuite) Test_NewRateLimiter_invalid(c *C) {
+	_, err := NewRateLimiter(0)
+	c.Assert(err, ErrorMatches, "Rate limit must be positive, not 0")
+	_, err = NewRateLimiter(math.NaN())
+	c.Assert(err, ErrorMatches, "Rate limit must be positive, not NaN")
+
+	client, _ := NewApiClient("US", "")
+	c.Assert(WithRateLimit(-1)(client), ErrorMatches, "Rate limit must be positive, not -1")
+	c.Assert(client.Limiter, IsNil)
+	_, err = NewApiClientFromConfig(ClientConfig{Region: "US"}, WithRateLimit(-1))
+	c.Assert(err, ErrorMatches, "Rate limit must be positive, not -1")
+}
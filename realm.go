@@ -7,3 +7,7 @@ type Realm struct {
 	Locale      string
 	Timezone    string
 }
+
+func (r *Realm) GetName() string {
+	return r.Name
+}
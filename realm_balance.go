@@ -0,0 +1,109 @@
+package wow
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Population is a realm's population level, as reported by the realm
+// status.
+type Population int
+
+const (
+	UnknownPopulation Population = iota
+	LowPopulation
+	MediumPopulation
+	HighPopulation
+	FullPopulation
+)
+
+var populationNames = []string{"n/a", "low", "medium", "high", "full"}
+
+func (p Population) String() string {
+	if p < 0 || int(p) >= len(populationNames) {
+		return populationNames[UnknownPopulation]
+	}
+	return populationNames[p]
+}
+
+// PopulationLevel returns the realm's Population typed.
+func (r *RealmStatus) PopulationLevel() Population {
+	for i, name := range populationNames {
+		if name == r.Population {
+			return Population(i)
+		}
+	}
+	return UnknownPopulation
+}
+
+// RealmBalanceStats is a realm's population and its faction balance.
+// The API doesn't report faction populations, so Alliance and Horde
+// count the distinct characters of the realm's connected realm group
+// on the PvP leaderboards, a sample skewed towards active PvPers.
+type RealmBalanceStats struct {
+	Realm      string
+	Population Population
+	Alliance   int
+	Horde      int
+}
+
+// Sampled returns how many characters the faction balance is based on.
+func (s *RealmBalanceStats) Sampled() int {
+	return s.Alliance + s.Horde
+}
+
+// AllianceShare returns the share of sampled characters that are
+// Alliance, from 0 to 1, or 0 if none were sampled.
+func (s *RealmBalanceStats) AllianceShare() float64 {
+	if s.Sampled() == 0 {
+		return 0
+	}
+	return float64(s.Alliance) / float64(s.Sampled())
+}
+
+// RealmBalance returns the population of the realm with the given slug
+// and estimates its faction balance from the PvP leaderboards. Brackets
+// whose leaderboard can't be fetched are left out of the sample, unless
+// all of them fail.
+func (a *ApiClient) RealmBalance(slug string) (*RealmBalanceStats, error) {
+	realms, err := a.GetRealmStatusFiltered([]string{slug})
+	if err != nil {
+		return nil, err
+	}
+	var realm *RealmStatus
+	for _, r := range realms {
+		if r.Slug == slug {
+			realm = r
+		}
+	}
+	if realm == nil {
+		return nil, errors.New(fmt.Sprintf("Realm '%s' not found", slug))
+	}
+
+	leaderboards, err := a.GetAllLeaderboards()
+	if err != nil && len(leaderboards) == 0 {
+		return nil, err
+	}
+
+	group := map[string]bool{slug: true}
+	for _, connected := range realm.ConnectedRealms {
+		group[connected] = true
+	}
+	stats := &RealmBalanceStats{Realm: slug, Population: realm.PopulationLevel()}
+	seen := make(map[string]bool)
+	for _, rows := range leaderboards {
+		for _, row := range rows {
+			key := row.Name + "-" + row.RealmSlug
+			if !group[row.RealmSlug] || seen[key] {
+				continue
+			}
+			seen[key] = true
+			if row.FactionId == 0 {
+				stats.Alliance++
+			} else {
+				stats.Horde++
+			}
+		}
+	}
+	return stats, nil
+}
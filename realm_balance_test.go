@@ -0,0 +1,55 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+)
+
+type RealmBalanceSuite struct{}
+
+var _ = Suite(&RealmBalanceSuite{})
+
+func (s *RealmBalanceSuite) Test_RealmBalance(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wow/realm/status":
+			if r.URL.Query().Get("realms") != "medivh" {
+				w.Write([]byte(`{"realms": []}`))
+				return
+			}
+			w.Write([]byte(`{"realms": [{"population": "high", "name": "Medivh", "slug": "medivh", "connected_realms": ["medivh", "exodar"]}]}`))
+		case "/wow/leaderboard/2v2", "/wow/leaderboard/3v3":
+			w.Write([]byte(`{"rows": [
+				{"name": "Capoferro", "realmSlug": "medivh", "factionId": 0},
+				{"name": "Someone", "realmSlug": "exodar", "factionId": 1},
+				{"name": "Elsewhere", "realmSlug": "runetotem", "factionId": 1}
+			]}`))
+		case "/wow/leaderboard/5v5":
+			w.Write([]byte(`{"rows": [{"name": "Another", "realmSlug": "medivh", "factionId": 0}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	stats, err := client.RealmBalance("medivh")
+	c.Assert(err, IsNil)
+	c.Assert(stats.Population, Equals, HighPopulation)
+	c.Assert(stats.Population.String(), Equals, "high")
+	c.Assert(stats.Alliance, Equals, 2)
+	c.Assert(stats.Horde, Equals, 1)
+	c.Assert(stats.Sampled(), Equals, 3)
+	c.Assert(stats.AllianceShare() > 0.66 && stats.AllianceShare() < 0.67, Equals, true)
+
+	_, err = client.RealmBalance("nowhere")
+	c.Assert(err, ErrorMatches, "Realm 'nowhere' not found")
+}
+
+func (s *RealmBalanceSuite) Test_PopulationLevel(c *C) {
+	c.Assert((&RealmStatus{Population: "full"}).PopulationLevel(), Equals, FullPopulation)
+	c.Assert((&RealmStatus{Population: "n/a"}).PopulationLevel(), Equals, UnknownPopulation)
+	c.Assert((&RealmStatus{}).PopulationLevel(), Equals, UnknownPopulation)
+	c.Assert((&RealmBalanceStats{}).AllianceShare(), Equals, 0.0)
+}
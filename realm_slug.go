@@ -0,0 +1,49 @@
+package wow
+
+import (
+	"strings"
+	"unicode"
+)
+
+var slugReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n", "ß", "ss",
+)
+
+// RealmSlug converts a realm name to the slug the API uses for it, e.g.
+// "Argent Dawn" to "argent-dawn", "Mal'Ganis" to "malganis" and "Aggra
+// (Português)" to "aggra-portugues". Anything that already looks like a
+// slug is returned unchanged.
+func RealmSlug(name string) string {
+	name = strings.TrimSpace(name)
+	if isSlug(name) {
+		return name
+	}
+
+	words := make([]string, 0)
+	for _, word := range strings.Fields(slugReplacer.Replace(strings.ToLower(name))) {
+		word = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, word)
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, "-")
+}
+
+func isSlug(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLower(r) && !unicode.IsDigit(r) && r != '-' {
+			return false
+		}
+	}
+	return true
+}
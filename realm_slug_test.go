@@ -0,0 +1,19 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type RealmSlugSuite struct{}
+
+var _ = Suite(&RealmSlugSuite{})
+
+func (s *RealmSlugSuite) Test_RealmSlug(c *C) {
+	c.Assert(RealmSlug("Runetotem"), Equals, "runetotem")
+	c.Assert(RealmSlug("Argent Dawn"), Equals, "argent-dawn")
+	c.Assert(RealmSlug("Mal'Ganis"), Equals, "malganis")
+	c.Assert(RealmSlug("Azjol-Nerub"), Equals, "azjolnerub")
+	c.Assert(RealmSlug("Aggra (Português)"), Equals, "aggra-portugues")
+	c.Assert(RealmSlug("Area 52"), Equals, "area-52")
+	c.Assert(RealmSlug(" argent-dawn "), Equals, "argent-dawn")
+}
@@ -12,4 +12,32 @@ type RealmStatus struct {
 	Battlegroup string
 	Locale      string
 	Timezone    string
+	// Slugs of every realm in this realm's connected realm group,
+	// including its own.
+	ConnectedRealms []string `json:"connected_realms"`
+}
+
+// Realm returns the subset of the realm status shared with Realm.
+func (r *RealmStatus) Realm() *Realm {
+	return &Realm{
+		Name:        r.Name,
+		Slug:        r.Slug,
+		Battlegroup: r.Battlegroup,
+		Locale:      r.Locale,
+		Timezone:    r.Timezone,
+	}
+}
+
+// PrimaryRealm returns the slug of the primary realm of the realm's
+// connected realm group, which identifies the group. It's the realm's
+// own slug if it isn't connected to others.
+func (r *RealmStatus) PrimaryRealm() string {
+	if len(r.ConnectedRealms) == 0 {
+		return r.Slug
+	}
+	return r.ConnectedRealms[0]
+}
+
+func (r *RealmStatus) GetName() string {
+	return r.Name
 }
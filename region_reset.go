@@ -0,0 +1,50 @@
+package wow
+
+import (
+	"time"
+	// Embeds the zone database, so resets are computed in the regions'
+	// local time even on systems without one.
+	_ "time/tzdata"
+)
+
+// regionReset is when a region's weekly reset happens, in the local
+// time of the region's servers.
+type regionReset struct {
+	weekday time.Weekday
+	hour    int
+	zone    string
+}
+
+var regionResets = map[string]regionReset{
+	"US": regionReset{time.Tuesday, 8, "America/Los_Angeles"},
+	"EU": regionReset{time.Wednesday, 7, "Europe/Paris"},
+	"KR": regionReset{time.Thursday, 8, "Asia/Seoul"},
+	"TW": regionReset{time.Thursday, 8, "Asia/Taipei"},
+	"ZH": regionReset{time.Thursday, 8, "Asia/Shanghai"},
+}
+
+// NextRegionReset returns the time, in UTC, of the next weekly reset
+// of raid lockouts and other weekly content in the client's region.
+// Clients not created with NewApiClient use the US schedule.
+func (a *ApiClient) NextRegionReset() time.Time {
+	return nextRegionReset(a.region, time.Now())
+}
+
+func nextRegionReset(region string, now time.Time) time.Time {
+	reset, ok := regionResets[regionCode(region)]
+	if !ok {
+		reset = regionResets["US"]
+	}
+	location, err := time.LoadLocation(reset.zone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	days := (int(reset.weekday) - int(local.Weekday()) + 7) % 7
+	next := time.Date(local.Year(), local.Month(), local.Day()+days, reset.hour, 0, 0, 0, location)
+	if !next.After(now) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+days+7, reset.hour, 0, 0, 0, location)
+	}
+	return next.UTC()
+}
@@ -0,0 +1,28 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+	"time"
+)
+
+type RegionResetSuite struct{}
+
+var _ = Suite(&RegionResetSuite{})
+
+func (s *RegionResetSuite) Test_nextRegionReset(c *C) {
+	// Monday 2014-06-02 12:00 UTC.
+	now := time.Date(2014, 6, 2, 12, 0, 0, 0, time.UTC)
+	c.Assert(nextRegionReset("US", now), Equals, time.Date(2014, 6, 3, 15, 0, 0, 0, time.UTC))
+	c.Assert(nextRegionReset("EU", now), Equals, time.Date(2014, 6, 4, 5, 0, 0, 0, time.UTC))
+	c.Assert(nextRegionReset("KR", now), Equals, time.Date(2014, 6, 4, 23, 0, 0, 0, time.UTC))
+
+	// At the reset itself, the next one is a week later.
+	c.Assert(nextRegionReset("US", time.Date(2014, 6, 3, 15, 0, 0, 0, time.UTC)), Equals, time.Date(2014, 6, 10, 15, 0, 0, 0, time.UTC))
+	// Winter time in the US.
+	c.Assert(nextRegionReset("US", time.Date(2014, 12, 3, 0, 0, 0, 0, time.UTC)), Equals, time.Date(2014, 12, 9, 16, 0, 0, 0, time.UTC))
+}
+
+func (s *RegionResetSuite) Test_nextRegionReset_regionName(c *C) {
+	now := time.Date(2014, 6, 2, 12, 0, 0, 0, time.UTC)
+	c.Assert(nextRegionReset("Europe", now), Equals, nextRegionReset("EU", now))
+}
@@ -0,0 +1,38 @@
+package wow
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIdHeader is the header that carries a request's correlation
+// id.
+const RequestIdHeader = "X-Request-ID"
+
+type contextRequestIdKey struct{}
+
+// WithContextRequestId returns a copy of ctx carrying id. API requests
+// made by a client from WithContext(ctx) are sent with id as their
+// X-Request-ID, so they can be found in logs and traces by it.
+func WithContextRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextRequestIdKey{}, id)
+}
+
+// requestId returns the id for a new API request: the context's if it
+// has one, a random one if the client generates them, and "" if
+// requests don't get ids. Retries of a request keep its id.
+func (a *ApiClient) requestId() string {
+	if id, ok := a.Context().Value(contextRequestIdKey{}).(string); ok && id != "" {
+		return id
+	}
+	if !a.GenerateRequestIds {
+		return ""
+	}
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return ""
+	}
+	return hex.EncodeToString(id)
+}
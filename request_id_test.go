@@ -0,0 +1,65 @@
+package wow
+
+import (
+	"context"
+	"errors"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+type RequestIdSuite struct{}
+
+var _ = Suite(&RequestIdSuite{})
+
+func (s *RequestIdSuite) Test_requestId(c *C) {
+	ids := make([]string, 0)
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(RequestIdHeader))
+		switch r.URL.Path {
+		case "/wow/quest/404":
+			w.WriteHeader(http.StatusNotFound)
+		case "/wow/quest/503":
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fallthrough
+		default:
+			w.Write([]byte(`{"id": 13146}`))
+		}
+	}))
+	defer server.Close()
+	client := testClient(server)
+	client.RetryBackoff = time.Millisecond
+	events := make([]*Event, 0)
+	client.Observer = ObserverFunc(func(event *Event) {
+		events = append(events, event)
+	})
+
+	_, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(ids, DeepEquals, []string{""})
+	c.Assert(len(events), Equals, 0)
+
+	traced := client.WithContext(WithContextRequestId(context.Background(), "trace-1"))
+	_, err = traced.GetQuest(404)
+	var apiErr *ApiError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.RequestId, Equals, "trace-1")
+	c.Assert(ids[1], Equals, "trace-1")
+	c.Assert(events, DeepEquals, []*Event{&Event{Type: RequestEvent, Path: "quest/404", Message: "404 Not Found", RequestId: "trace-1"}})
+
+	client.GenerateRequestIds = true
+	_, err = client.GetQuest(503)
+	c.Assert(err, IsNil)
+	c.Assert(len(ids), Equals, 4)
+	c.Assert(len(ids[2]), Equals, 32)
+	c.Assert(ids[3], Equals, ids[2])
+	_, err = client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(ids[4], Not(Equals), ids[2])
+}
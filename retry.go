@@ -0,0 +1,86 @@
+package wow
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxRetries is how many times NewApiClient's clients retry a
+// request that was rate limited or hit a transient server error.
+const DefaultMaxRetries = 3
+
+// DefaultRetryBackoff is the delay before the first retry, which
+// doubles with each further retry.
+const DefaultRetryBackoff = 500 * time.Millisecond
+
+// DefaultMaxRetryAfter is the longest Retry-After a client waits for
+// unless its MaxRetryAfter says otherwise.
+const DefaultMaxRetryAfter = time.Minute
+
+// retryable reports whether a response with the status code is worth
+// retrying: rate limiting and transient server errors.
+func retryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before retry number attempt
+// (from 0) of a request that got response. A Retry-After header is
+// honoured, unless it asks for longer than MaxRetryAfter, in which case
+// ok is false and the request shouldn't be retried. Otherwise the delay
+// is RetryBackoff doubled per attempt, with jitter so clients that
+// failed together don't retry together.
+func (a *ApiClient) retryDelay(attempt int, response *http.Response) (delay time.Duration, ok bool) {
+	if after := response.Header.Get("Retry-After"); after != "" {
+		delay, parsed := parseRetryAfter(after)
+		if parsed {
+			return delay, delay <= a.maxRetryAfter()
+		}
+	}
+	backoff := a.RetryBackoff << uint(attempt)
+	if backoff <= 0 {
+		return 0, true
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1)), true
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number
+// of seconds or a date.
+func parseRetryAfter(after string) (time.Duration, bool) {
+	if seconds, err := strconv.Atoi(after); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(after); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func (a *ApiClient) maxRetryAfter() time.Duration {
+	if a.MaxRetryAfter == 0 {
+		return DefaultMaxRetryAfter
+	}
+	return a.MaxRetryAfter
+}
+
+// sleep waits for delay, returning the context's error early if it's
+// done first.
+func (a *ApiClient) sleep(delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-a.Context().Done():
+		return a.Context().Err()
+	}
+}
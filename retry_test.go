@@ -0,0 +1,128 @@
+package wow
+
+import (
+	"context"
+	"errors"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+type RetrySuite struct{}
+
+var _ = Suite(&RetrySuite{})
+
+func (s *RetrySuite) Test_retry(c *C) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(`{"id": 13146, "title": "Generosity"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.RetryBackoff = time.Millisecond
+	quest, err := client.GetQuest(13146)
+	c.Assert(err, IsNil)
+	c.Assert(quest.Id, Equals, 13146)
+	c.Assert(attempts, Equals, 3)
+}
+
+func (s *RetrySuite) Test_retry_exhausted(c *C) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.RetryBackoff = time.Millisecond
+	_, err := client.GetQuest(13146)
+	var apiErr *ApiError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusBadGateway)
+	c.Assert(attempts, Equals, DefaultMaxRetries+1)
+}
+
+func (s *RetrySuite) Test_retry_notRetryable(c *C) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.RetryBackoff = time.Millisecond
+	_, err := client.GetQuest(13146)
+	c.Assert(err, NotNil)
+	c.Assert(attempts, Equals, 1)
+}
+
+func (s *RetrySuite) Test_retry_cancelled(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	client := testClient(server)
+	client.RetryBackoff = time.Millisecond
+	_, err := client.WithContext(ctx).GetQuest(13146)
+	c.Assert(err, Equals, context.DeadlineExceeded)
+}
+
+func (s *RetrySuite) Test_retryDelay(c *C) {
+	client, _ := NewApiClient("US", "")
+	response := &http.Response{Header: http.Header{}}
+	for attempt := 0; attempt < 3; attempt++ {
+		backoff := DefaultRetryBackoff << uint(attempt)
+		delay, ok := client.retryDelay(attempt, response)
+		c.Assert(ok, Equals, true)
+		c.Assert(delay >= backoff/2 && delay <= backoff, Equals, true)
+	}
+
+	response.Header.Set("Retry-After", "7")
+	delay, ok := client.retryDelay(0, response)
+	c.Assert(delay, Equals, 7*time.Second)
+	c.Assert(ok, Equals, true)
+	response.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	delay, ok = client.retryDelay(0, response)
+	c.Assert(delay, Equals, time.Duration(0))
+	c.Assert(ok, Equals, true)
+	response.Header.Set("Retry-After", "3600")
+	_, ok = client.retryDelay(0, response)
+	c.Assert(ok, Equals, false)
+}
+
+func (s *RetrySuite) Test_retry_retryAfterTooLong(c *C) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	client := testClient(server)
+	client.RetryBackoff = time.Millisecond
+	_, err := client.GetQuest(13146)
+	var apiErr *ApiError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusServiceUnavailable)
+	c.Assert(attempts, Equals, 1)
+	c.Assert(time.Since(start) < time.Second, Equals, true)
+}
@@ -0,0 +1,37 @@
+package wow
+
+// Role is the group role a specialization fills.
+type Role int
+
+const (
+	RoleUnknown Role = iota
+	RoleTank
+	RoleHealer
+	RoleDPS
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleTank:
+		return "Tank"
+	case RoleHealer:
+		return "Healer"
+	case RoleDPS:
+		return "DPS"
+	}
+	return "Unknown"
+}
+
+// ParseRole converts the role string of a Spec ("TANK", "HEALING" or
+// "DPS") to a Role.
+func ParseRole(role string) Role {
+	switch role {
+	case "TANK":
+		return RoleTank
+	case "HEALING":
+		return RoleHealer
+	case "DPS":
+		return RoleDPS
+	}
+	return RoleUnknown
+}
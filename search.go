@@ -0,0 +1,73 @@
+package wow
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// SearchResult is one page of results from a search endpoint. Results
+// are left undecoded since their type depends on the resource.
+type SearchResult struct {
+	Page        int
+	PageSize    int
+	MaxPageSize int
+	PageCount   int
+	Results     []json.RawMessage
+}
+
+// Search queries the search endpoint of resource ("search/" followed
+// by resource), for resources that have one; items don't, see
+// ItemIndex. query holds the search's filters; the page is chosen
+// with a "_page" entry and defaults to the first.
+func (a *ApiClient) Search(resource string, query map[string]string) (*SearchResult, error) {
+	params := make(map[string]string)
+	for k, v := range query {
+		params[k] = v
+	}
+	jsonBlob, err := a.getWithParams("search/"+resource, params)
+	if err != nil {
+		return nil, err
+	}
+	result := &SearchResult{}
+	err = json.Unmarshal(jsonBlob, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SearchAll runs the search and returns the results of every page.
+func (a *ApiClient) SearchAll(resource string, query map[string]string) ([]json.RawMessage, error) {
+	results := make([]json.RawMessage, 0)
+	err := paginate(func(page int) (int, error) {
+		params := make(map[string]string)
+		for k, v := range query {
+			params[k] = v
+		}
+		params["_page"] = strconv.Itoa(page)
+		result, err := a.Search(resource, params)
+		if err != nil {
+			return 0, err
+		}
+		results = append(results, result.Results...)
+		return result.PageCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// paginate calls fetchPage for pages 1, 2, ... until it has fetched the
+// number of pages fetchPage reports, or fetchPage fails.
+func paginate(fetchPage func(page int) (pageCount int, err error)) error {
+	for page := 1; ; page++ {
+		pageCount, err := fetchPage(page)
+		if err != nil {
+			return err
+		}
+		if page >= pageCount {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package wow
+
+import (
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+type SearchSuite struct{}
+
+var _ = Suite(&SearchSuite{})
+
+func (s *SearchSuite) Test_SearchAll(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/wow/search/item")
+		c.Check(r.URL.Query().Get("name.en_US"), Equals, "Thunderfury")
+		page := r.URL.Query().Get("_page")
+		fmt.Fprintf(w, `{"page": %s, "pageSize": 1, "maxPageSize": 100, "pageCount": 3, "results": [{"id": %s}]}`, page, page)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	results, err := client.SearchAll("item", map[string]string{"name.en_US": "Thunderfury"})
+	c.Assert(err, IsNil)
+	c.Assert(len(results), Equals, 3)
+	c.Assert(string(results[2]), Equals, `{"id": 3}`)
+
+	result, err := client.Search("item", map[string]string{"name.en_US": "Thunderfury", "_page": "2"})
+	c.Assert(err, IsNil)
+	c.Assert(result.Page, Equals, 2)
+	c.Assert(result.PageCount, Equals, 3)
+}
+
+func (s *SearchSuite) Test_NewItemIndex(c *C) {
+	names := map[string]string{
+		"/wow/item/19019": "Thunderfury, Blessed Blade of the Windseeker",
+		"/wow/item/17":    "Martin Fury",
+		"/wow/item/18":    "martin fury",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, ok := names[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %s, "name": "%s"}`, strings.TrimPrefix(r.URL.Path, "/wow/item/"), name)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	index, errs := client.NewItemIndex([]int{18, 19019, 17, 404, 18}, 0)
+	c.Assert(errs, HasLen, 1)
+	items := index.ItemsNamed("Thunderfury, Blessed Blade of the Windseeker")
+	c.Assert(items, HasLen, 1)
+	c.Assert(items[0].Id, Equals, 19019)
+	items = index.ItemsNamed("MARTIN FURY")
+	c.Assert(items, HasLen, 2)
+	c.Assert(items[0].Id, Equals, 17)
+	c.Assert(items[1].Id, Equals, 18)
+	c.Assert(index.ItemsNamed("Thunderfury"), HasLen, 0)
+}
+
+func (s *SearchSuite) Test_paginate_error(c *C) {
+	pages := 0
+	err := paginate(func(page int) (int, error) {
+		pages++
+		if page == 2 {
+			return 0, fmt.Errorf("page %d failed", page)
+		}
+		return 5, nil
+	})
+	c.Assert(err.Error(), Equals, "page 2 failed")
+	c.Assert(pages, Equals, 2)
+}
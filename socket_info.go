@@ -0,0 +1,9 @@
+package wow
+
+type SocketInfo struct {
+	Sockets []*Socket
+}
+
+type Socket struct {
+	Type string
+}
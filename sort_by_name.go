@@ -0,0 +1,72 @@
+package wow
+
+import (
+	"sort"
+	"strings"
+)
+
+// Named is implemented by the types that have a localized name, such
+// as Realm, Item and Class.
+type Named interface {
+	GetName() string
+}
+
+// SortByName sorts list by name for readers of locale (e.g. the
+// client's Locale): case is ignored and accented letters sort with
+// their base letter, so "Élune" comes before "Vol'jin", except that
+// Spanish sorts ñ after n. Scripts other than Latin and Cyrillic are
+// left in code point order. The sort is stable.
+func SortByName(list []Named, locale string) {
+	keys := make([]string, len(list))
+	for i, named := range list {
+		keys[i] = nameSortKey(named.GetName(), locale)
+	}
+	sort.Stable(&namesByKey{keys, list})
+}
+
+type namesByKey struct {
+	keys []string
+	list []Named
+}
+
+func (n *namesByKey) Len() int           { return len(n.keys) }
+func (n *namesByKey) Less(i, j int) bool { return n.keys[i] < n.keys[j] }
+func (n *namesByKey) Swap(i, j int) {
+	n.keys[i], n.keys[j] = n.keys[j], n.keys[i]
+	n.list[i], n.list[j] = n.list[j], n.list[i]
+}
+
+// nameSortKey returns name lowercased with accents removed, followed
+// by the name itself so names differing only in case or accents still
+// sort consistently.
+func nameSortKey(name string, locale string) string {
+	spanish := strings.HasPrefix(locale, "es_")
+	var key strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r == 'ñ' && spanish:
+			// After every other n.
+			key.WriteString("n\U0010FFFF")
+		case foldedLetters[r] != "":
+			key.WriteString(foldedLetters[r])
+		default:
+			key.WriteRune(r)
+		}
+	}
+	return key.String() + "\x00" + name
+}
+
+// foldedLetters maps accented lowercase letters to the letters they
+// sort as.
+var foldedLetters = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u",
+	'ý': "y", 'ÿ': "y",
+	'ß': "ss",
+	'ё': "е",
+}
@@ -0,0 +1,37 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type SortByNameSuite struct{}
+
+var _ = Suite(&SortByNameSuite{})
+
+func names(list []Named) []string {
+	names := make([]string, len(list))
+	for i, named := range list {
+		names[i] = named.GetName()
+	}
+	return names
+}
+
+func (s *SortByNameSuite) Test_SortByName(c *C) {
+	realms := []Named{&Realm{Name: "Vol'jin"}, &Realm{Name: "Élune"}, &Realm{Name: "Dalaran"}, &Realm{Name: "Eitrigg"}}
+	SortByName(realms, "fr_FR")
+	c.Assert(names(realms), DeepEquals, []string{"Dalaran", "Eitrigg", "Élune", "Vol'jin"})
+}
+
+func (s *SortByNameSuite) Test_SortByName_spanish(c *C) {
+	items := []Named{&Item{Name: "Ñandú"}, &Item{Name: "nudo"}, &Item{Name: "oro"}, &Item{Name: "Ánfora"}}
+	SortByName(items, "es_ES")
+	c.Assert(names(items), DeepEquals, []string{"Ánfora", "nudo", "Ñandú", "oro"})
+	SortByName(items, "fr_FR")
+	c.Assert(names(items), DeepEquals, []string{"Ánfora", "Ñandú", "nudo", "oro"})
+}
+
+func (s *SortByNameSuite) Test_SortByName_mixedTypes(c *C) {
+	list := []Named{&Class{Name: "Mage"}, &Race{Name: "Draenei"}, &Realm{Name: "Azralon"}}
+	SortByName(list, "en_US")
+	c.Assert(names(list), DeepEquals, []string{"Azralon", "Draenei", "Mage"})
+}
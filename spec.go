@@ -8,3 +8,30 @@ type Spec struct {
 	Order           int
 	Role            string
 }
+
+// Specialization ids per class id, indexed by Spec.Order. The API
+// identifies specs by their order within the class, but other sources
+// (e.g. combat logs) use these ids.
+var specIds = map[int][]int{
+	1:  []int{71, 72, 73},
+	2:  []int{65, 66, 70},
+	3:  []int{253, 254, 255},
+	4:  []int{259, 260, 261},
+	5:  []int{256, 257, 258},
+	6:  []int{250, 251, 252},
+	7:  []int{262, 263, 264},
+	8:  []int{62, 63, 64},
+	9:  []int{265, 266, 267},
+	10: []int{268, 270, 269},
+	11: []int{102, 103, 104, 105},
+}
+
+// SpecId returns the specialization id of the class's spec with the
+// given order, or false if there is no such spec.
+func SpecId(classId int, order int) (int, bool) {
+	ids := specIds[classId]
+	if order < 0 || order >= len(ids) {
+		return 0, false
+	}
+	return ids[order], true
+}
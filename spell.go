@@ -10,3 +10,7 @@ type Spell struct {
 	Range       string
 	PowerCost   string
 }
+
+func (s *Spell) GetName() string {
+	return s.Name
+}
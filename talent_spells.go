@@ -0,0 +1,24 @@
+package wow
+
+// ResolveTalentSpells fetches the spell of every talent in t, keyed by
+// spell id, for the icons and descriptions a talent calculator shows.
+// Spells are fetched concurrently, once each, and cached, so resolving
+// them again doesn't make requests. Spells that fail to load are left
+// out and their errors returned.
+func (a *ApiClient) ResolveTalentSpells(t *TalentList) (map[int]*Spell, []error) {
+	ids := make([]int, 0)
+	for _, tier := range t.Talents {
+		for _, talent := range tier {
+			if talent != nil && talent.Spell != nil {
+				ids = append(ids, talent.Spell.Id)
+			}
+		}
+	}
+
+	resolved, failed := a.resolveIds(ids, 0, "spell/%d", func() interface{} { return &Spell{} })
+	spells := make(map[int]*Spell)
+	for id, spell := range resolved {
+		spells[id] = spell.(*Spell)
+	}
+	return spells, loadErrors("spell", failed)
+}
@@ -0,0 +1,50 @@
+package wow
+
+import (
+	"fmt"
+	. "launchpad.net/gocheck"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+type TalentSpellsSuite struct{}
+
+var _ = Suite(&TalentSpellsSuite{})
+
+func (s *TalentSpellsSuite) Test_ResolveTalentSpells(c *C) {
+	var mutex sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests++
+		mutex.Unlock()
+		if r.URL.Path == "/wow/spell/3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/wow/spell/")
+		fmt.Fprintf(w, `{"id": %s, "name": "Spell %s", "icon": "icon_%s", "description": "Does %s things."}`, id, id, id, id)
+	}))
+	defer server.Close()
+	client := testClient(server)
+
+	t := &TalentList{}
+	t.Talents[0][0] = &Talent{Spell: &Spell{Id: 1}}
+	t.Talents[0][2] = &Talent{Spell: &Spell{Id: 2}}
+	t.Talents[1][1] = &Talent{Spell: &Spell{Id: 3}}
+	t.Talents[5][0] = &Talent{Spell: &Spell{Id: 1}}
+
+	spells, errs := client.ResolveTalentSpells(t)
+	c.Assert(len(errs), Equals, 1)
+	c.Assert(errs[0], ErrorMatches, "Could not load spell 3: .*")
+	c.Assert(len(spells), Equals, 2)
+	c.Assert(spells[2].Icon, Equals, "icon_2")
+	c.Assert(spells[1].Description, Equals, "Does 1 things.")
+	c.Assert(requests, Equals, 3)
+
+	_, errs = client.ResolveTalentSpells(t)
+	c.Assert(len(errs), Equals, 1)
+	c.Assert(requests, Equals, 4)
+}
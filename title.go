@@ -1,6 +1,17 @@
 package wow
 
+import (
+	"strings"
+)
+
 type Title struct {
-	Id   int
-	Name string
+	Id       int
+	Name     string
+	Selected bool
+}
+
+// Format renders the title for a character name. Title names are
+// format strings such as "%s the Explorer".
+func (t *Title) Format(characterName string) string {
+	return strings.Replace(t.Name, "%s", characterName, 1)
 }
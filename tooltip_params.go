@@ -1,11 +1,44 @@
 package wow
 
+import (
+	"encoding/json"
+)
+
 type TooltipParams struct {
 	Gem0         int
 	Gem1         int
 	Gem2         int
+	Enchant      int
+	ExtraSocket  bool
 	Set          []int
 	Reforge      int
+	Tinker       int
+	Suffix       int
+	Seed         int64
 	TransmogItem int
 	Upgrade      *Upgrade
+	// Raw holds every tooltip param as returned by the API, including
+	// any not modeled above.
+	Raw map[string]json.RawMessage
+}
+
+func (t *TooltipParams) UnmarshalJSON(data []byte) error {
+	type tooltipParams TooltipParams
+	params := (*tooltipParams)(t)
+	err := json.Unmarshal(data, params)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &t.Raw)
+}
+
+// Gems returns the ids of the gems socketed into the item.
+func (t *TooltipParams) Gems() []int {
+	gems := make([]int, 0, 3)
+	for _, gem := range []int{t.Gem0, t.Gem1, t.Gem2} {
+		if gem != 0 {
+			gems = append(gems, gem)
+		}
+	}
+	return gems
 }
@@ -0,0 +1,78 @@
+package wow
+
+import (
+	"sort"
+)
+
+// UpgradeSuggestion is an auction whose item would raise the item
+// level of a slot.
+type UpgradeSuggestion struct {
+	Slot          string
+	Item          *Item
+	Auction       *Auction
+	Price         int64
+	ItemLevelGain int
+}
+
+// SuggestUpgrades finds, for each equipment slot, the auction that
+// raises the slot's item level the most for a buyout within budget (in
+// copper), preferring the cheaper auction on ties. Each auction is
+// suggested for one slot at most, so two rings or trinkets are needed
+// to fill both slots. Slots without an upgrade are left out.
+//
+// Auctions don't say an item's level or slot, so they're looked up in
+// index, e.g. one made by NewItemIndex from the ids of the auctioned
+// items of interest; auctions of items it doesn't have are skipped.
+// Class and armor type restrictions aren't considered.
+func SuggestUpgrades(items *ItemList, auctions *Auctions, index *ItemIndex, budget int64) map[string]*UpgradeSuggestion {
+	candidates := make([]*UpgradeSuggestion, 0)
+	for _, auction := range auctions.Auctions {
+		if auction.Buyout <= 0 || auction.Buyout > budget {
+			continue
+		}
+		item := index.Item(auction.Item)
+		if item == nil {
+			continue
+		}
+		for _, slot := range item.InventoryType.Slots() {
+			gain := item.ItemLevel - equippedLevel(items, slot)
+			if gain > 0 {
+				candidates = append(candidates, &UpgradeSuggestion{
+					Slot:          slot,
+					Item:          item,
+					Auction:       auction,
+					Price:         auction.Buyout,
+					ItemLevelGain: gain,
+				})
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].ItemLevelGain != candidates[j].ItemLevelGain {
+			return candidates[i].ItemLevelGain > candidates[j].ItemLevelGain
+		}
+		if candidates[i].Price != candidates[j].Price {
+			return candidates[i].Price < candidates[j].Price
+		}
+		return candidates[i].Auction.Auc < candidates[j].Auction.Auc
+	})
+	suggestions := make(map[string]*UpgradeSuggestion)
+	suggested := make(map[*Auction]bool)
+	for _, candidate := range candidates {
+		if suggestions[candidate.Slot] == nil && !suggested[candidate.Auction] {
+			suggestions[candidate.Slot] = candidate
+			suggested[candidate.Auction] = true
+		}
+	}
+	return suggestions
+}
+
+func equippedLevel(items *ItemList, slot string) int {
+	if items != nil {
+		if equipped := items.Slot(slot); equipped != nil {
+			return equipped.ItemLevel
+		}
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+package wow
+
+import (
+	. "launchpad.net/gocheck"
+)
+
+type UpgradeSuggestionSuite struct{}
+
+var _ = Suite(&UpgradeSuggestionSuite{})
+
+func (s *UpgradeSuggestionSuite) Test_SuggestUpgrades(c *C) {
+	index := newItemIndex([]*Item{
+		&Item{Id: 1, ItemLevel: 500, InventoryType: InventoryTypeHead},
+		&Item{Id: 2, ItemLevel: 510, InventoryType: InventoryTypeHead},
+		&Item{Id: 3, ItemLevel: 480, InventoryType: InventoryTypeFinger},
+		&Item{Id: 4, ItemLevel: 520, InventoryType: InventoryTypeChest},
+		&Item{Id: 5, ItemLevel: 495, InventoryType: InventoryTypeFinger},
+	})
+	items := &ItemList{Head: &Item{Id: 10, ItemLevel: 496}, Finger1: &Item{Id: 11, ItemLevel: 490}}
+	auctions := &Auctions{Auctions: []*Auction{
+		&Auction{Auc: 1, Item: 1, Buyout: 1000},
+		&Auction{Auc: 2, Item: 2, Buyout: 5000},
+		&Auction{Auc: 3, Item: 2, Buyout: 4000},
+		&Auction{Auc: 4, Item: 3, Buyout: 100},
+		&Auction{Auc: 5, Item: 4, Buyout: 999999},
+		&Auction{Auc: 6, Item: 9, Buyout: 100},
+		&Auction{Auc: 7, Item: 5, Buyout: 300},
+		&Auction{Auc: 8, Item: 5, Buyout: 200},
+	}}
+
+	suggestions := SuggestUpgrades(items, auctions, index, 5000)
+	c.Assert(len(suggestions), Equals, 3)
+	c.Assert(suggestions["head"].Auction.Auc, Equals, 3)
+	c.Assert(suggestions["head"].ItemLevelGain, Equals, 14)
+	c.Assert(suggestions["head"].Price, Equals, int64(4000))
+	// Each ring auction fills one slot; the better slot gets the cheaper.
+	c.Assert(suggestions["finger2"].Auction.Auc, Equals, 8)
+	c.Assert(suggestions["finger2"].ItemLevelGain, Equals, 495)
+	c.Assert(suggestions["finger1"].Auction.Auc, Equals, 7)
+	c.Assert(suggestions["finger1"].ItemLevelGain, Equals, 5)
+	c.Assert(suggestions["chest"], IsNil)
+
+	c.Assert(SuggestUpgrades(items, auctions, newItemIndex(nil), 5000), HasLen, 0)
+}